@@ -3,16 +3,21 @@ package config
 import (
 	"fmt"
 	"path"
+	"time"
 
 	"github.com/ilyakaznacheev/cleanenv"
 )
 
 type (
 	Config struct {
-		App  `yaml:"app"`
-		HTTP `yaml:"http"`
-		Log  `yaml:"log"`
-		PG   `yaml:"postgres"`
+		App         `yaml:"app"`
+		HTTP        `yaml:"http"`
+		Log         `yaml:"log"`
+		PG          `yaml:"postgres"`
+		Review      `yaml:"review"`
+		Auth        `yaml:"auth"`
+		Idempotency `yaml:"idempotency"`
+		Webhooks    `yaml:"webhooks"`
 	}
 
 	App struct {
@@ -32,6 +37,50 @@ type (
 		MaxPoolSize int    `env-required:"true" yaml:"max_pool_size" env:"PG_MAX_POOL_SIZE"`
 		URL         string `env-required:"true"                      env:"PG_URL" yaml:"default_pg_url"`
 	}
+
+	Review struct {
+		Strategy string `env-default:"least_loaded" yaml:"strategy" env:"REVIEW_STRATEGY"`
+		// SeedFromPullRequest makes selection reproducible: the seed is derived from Seed and the PR id
+		SeedFromPullRequest bool  `env-default:"false" yaml:"seed_from_pull_request" env:"REVIEW_SEED_FROM_PR"`
+		Seed                int64 `env-default:"0"     yaml:"seed"                   env:"REVIEW_SEED"`
+	}
+
+	Auth struct {
+		// AdminToken bootstrap admin bearer token registered on startup, empty skips it
+		AdminToken string `env-default:"" yaml:"admin_token" env:"ADMIN_TOKEN"`
+		JWT        `yaml:"jwt"`
+	}
+
+	JWT struct {
+		// JWKSFile or JWKSURL enables JWT bearer tokens; the file wins when both are set
+		JWKSFile string `env-default:"" yaml:"jwks_file" env:"JWT_JWKS_FILE"`
+		JWKSURL  string `env-default:"" yaml:"jwks_url"  env:"JWT_JWKS_URL"`
+		// Issuer and Audience are checked when not empty
+		Issuer   string `env-default:"" yaml:"issuer"   env:"JWT_ISSUER"`
+		Audience string `env-default:"" yaml:"audience" env:"JWT_AUDIENCE"`
+	}
+
+	Idempotency struct {
+		// TTL how long the response of a request with an Idempotency-Key is replayed
+		TTL time.Duration `env-default:"24h" yaml:"ttl" env:"IDEMPOTENCY_TTL"`
+		// Lease how long a request still being served holds its key; a key of a
+		// request that crashed can be used again after it
+		Lease time.Duration `env-default:"1m" yaml:"lease" env:"IDEMPOTENCY_LEASE"`
+		// CleanupInterval how often expired keys are deleted
+		CleanupInterval time.Duration `env-default:"10m" yaml:"cleanup_interval" env:"IDEMPOTENCY_CLEANUP_INTERVAL"`
+	}
+
+	Webhooks struct {
+		// PollInterval how often the dispatcher looks for due deliveries
+		PollInterval time.Duration `env-default:"1s"  yaml:"poll_interval" env:"WEBHOOKS_POLL_INTERVAL"`
+		// Timeout of one delivery attempt
+		Timeout     time.Duration `env-default:"10s" yaml:"timeout"      env:"WEBHOOKS_TIMEOUT"`
+		MaxAttempts int           `env-default:"10"  yaml:"max_attempts" env:"WEBHOOKS_MAX_ATTEMPTS"`
+		// BaseBackoff delay after the first failure, doubled up to MaxBackoff
+		BaseBackoff time.Duration `env-default:"5s" yaml:"base_backoff" env:"WEBHOOKS_BASE_BACKOFF"`
+		MaxBackoff  time.Duration `env-default:"1h" yaml:"max_backoff"  env:"WEBHOOKS_MAX_BACKOFF"`
+		BatchSize   int           `env-default:"50" yaml:"batch_size"   env:"WEBHOOKS_BATCH_SIZE"`
+	}
 )
 
 func NewConfig(configPath string) (*Config, error) {
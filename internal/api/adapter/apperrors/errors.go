@@ -2,4 +2,16 @@ package apperrors
 
 import "errors"
 
-var ErrInvalidUUID = errors.New("invalid uuid format")
+var (
+	ErrInvalidUUID   = errors.New("invalid uuid format")
+	ErrInvalidCursor = errors.New("invalid cursor")
+	ErrInvalidLimit  = errors.New("limit must be between 1 and 100")
+	ErrEmptyBody     = errors.New("request body is empty")
+	ErrUnauthorized  = errors.New("missing or invalid bearer token")
+	ErrForbidden     = errors.New("operation is not allowed for this role")
+
+	ErrInvalidIdempotencyKey    = errors.New("idempotency key must be at most 255 characters")
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used for a different request")
+	ErrRequestTooLarge          = errors.New("request body is too large")
+)
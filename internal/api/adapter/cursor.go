@@ -0,0 +1,64 @@
+package adapter
+
+import (
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	"avito-test-applicant/internal/domain"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EncodeCursor packs the keyset position as "<unix nanos>:<id>" in url-safe base64
+func EncodeCursor(c domain.PullRequestCursor) string {
+	raw := strconv.FormatInt(c.CreatedAt.UnixNano(), 10) + ":" + c.PullRequestId.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeCursor(s string) (domain.PullRequestCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return domain.PullRequestCursor{}, apperrors.ErrInvalidCursor
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return domain.PullRequestCursor{}, apperrors.ErrInvalidCursor
+	}
+
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return domain.PullRequestCursor{}, apperrors.ErrInvalidCursor
+	}
+	prId, err := uuid.Parse(id)
+	if err != nil {
+		return domain.PullRequestCursor{}, apperrors.ErrInvalidCursor
+	}
+
+	return domain.PullRequestCursor{
+		CreatedAt:     time.Unix(0, n).UTC(),
+		PullRequestId: prId,
+	}, nil
+}
+
+// EncodeEventCursor packs the feed position in url-safe base64
+func EncodeEventCursor(c domain.EventCursor) string {
+	raw := strconv.FormatInt(c.Position, 10)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+func DecodeEventCursor(s string) (domain.EventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return domain.EventCursor{}, apperrors.ErrInvalidCursor
+	}
+
+	position, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil || position < 0 {
+		return domain.EventCursor{}, apperrors.ErrInvalidCursor
+	}
+
+	return domain.EventCursor{Position: position}, nil
+}
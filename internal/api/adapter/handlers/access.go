@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// Role checks per operation are done by the Authorize middleware; the
+// helpers below narrow them down to the resources a caller owns.
+// Unknown resources pass so the handler can answer with its own 404.
+
+func requireTeam(ctx context.Context, teamId uuid.UUID) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return apperrors.ErrUnauthorized
+	}
+	switch principal.Role {
+	case domain.RoleAdmin:
+		return nil
+	case domain.RoleTeamLead:
+		if principal.TeamId != nil && *principal.TeamId == teamId {
+			return nil
+		}
+	}
+	return apperrors.ErrForbidden
+}
+
+// requireMember lets admins through and any other role only within its
+// own team
+func requireMember(ctx context.Context, teamId uuid.UUID) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return apperrors.ErrUnauthorized
+	}
+	if principal.Role == domain.RoleAdmin {
+		return nil
+	}
+	if principal.TeamId != nil && *principal.TeamId == teamId {
+		return nil
+	}
+	return apperrors.ErrForbidden
+}
+
+// requireSelf lets callers of any role act only on their own behalf;
+// tokens without a user are rejected
+func requireSelf(ctx context.Context, userId uuid.UUID) error {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	if !ok {
+		return apperrors.ErrUnauthorized
+	}
+	if principal.UserId != nil && *principal.UserId == userId {
+		return nil
+	}
+	return apperrors.ErrForbidden
+}
+
+func requireAdmin(ctx context.Context) bool {
+	principal, ok := domain.PrincipalFromContext(ctx)
+	return ok && principal.Role == domain.RoleAdmin
+}
+
+func (s *Server) requireTeamByName(ctx context.Context, teamName string) error {
+	team, err := s.Services.Team.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return requireTeam(ctx, team.Team.TeamId)
+}
+
+func (s *Server) requireTeamOfUser(ctx context.Context, userId uuid.UUID) error {
+	user, err := s.Services.User.GetUserById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return requireTeam(ctx, user.TeamId)
+}
+
+// requireTeamOfPullRequest lets only members of the PR author's team work
+// on the PR; operations reserved for leads are narrowed down by the
+// Authorize middleware before this check
+func (s *Server) requireTeamOfPullRequest(ctx context.Context, prId uuid.UUID) error {
+	pr, err := s.Services.PullRequest.GetPullRequestById(ctx, prId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	author, err := s.Services.User.GetUserById(ctx, pr.PullRequest.AuthorId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	return requireMember(ctx, author.TeamId)
+}
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+func (s *Server) PostAuthToken(
+	ctx context.Context,
+	request apigen.PostAuthTokenRequestObject,
+) (apigen.PostAuthTokenResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	var userId *uuid.UUID
+	if request.Body.UserId != nil {
+		id, err := adapter.ParseUUID(*request.Body.UserId)
+		if err != nil {
+			return nil, err
+		}
+		userId = &id
+	}
+
+	token, apiToken, err := s.Services.Auth.IssueToken(ctx, domain.Role(request.Body.Role), userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidToken):
+			return apigen.PostAuthToken400JSONResponse(makeAPIError(ctx, apigen.INVALIDTOKENREQUEST, err.Error())), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return apigen.PostAuthToken404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostAuthToken201JSONResponse{
+		Token:    token,
+		ApiToken: adapter.MapAPITokenToAPI(apiToken),
+	}, nil
+}
+
+func (s *Server) PostAuthRevokeToken(
+	ctx context.Context,
+	request apigen.PostAuthRevokeTokenRequestObject,
+) (apigen.PostAuthRevokeTokenResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	tokenId, err := adapter.ParseUUID(request.Body.TokenId)
+	if err != nil {
+		return nil, err
+	}
+
+	apiToken, err := s.Services.Auth.RevokeToken(ctx, tokenId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.PostAuthRevokeToken404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.PostAuthRevokeToken200JSONResponse{
+		ApiToken: adapter.MapAPITokenToAPI(apiToken),
+	}, nil
+}
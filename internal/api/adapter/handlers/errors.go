@@ -1,12 +1,11 @@
 package handlers
 
 import (
+	"avito-test-applicant/internal/api/adapter/middleware"
 	apigen "avito-test-applicant/internal/api/gen"
+	"context"
 )
 
-func makeAPIError(code apigen.ErrorResponseErrorCode, message string) apigen.ErrorResponse {
-	var err apigen.ErrorResponse
-	err.Error.Code = code
-	err.Error.Message = message
-	return err
+func makeAPIError(ctx context.Context, code apigen.ErrorResponseErrorCode, message string) apigen.ErrorResponse {
+	return middleware.NewErrorResponse(ctx, code, message)
 }
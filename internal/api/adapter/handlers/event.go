@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const (
+	// eventStreamPollInterval how often the stream looks for new events
+	eventStreamPollInterval = time.Second
+	// eventStreamHeartbeat idle time after which a comment is sent, so
+	// proxies keep the connection and clients notice it is gone
+	eventStreamHeartbeat = 15 * time.Second
+)
+
+func (s *Server) GetEvents(
+	ctx context.Context,
+	request apigen.GetEventsRequestObject,
+) (apigen.GetEventsResponseObject, error) {
+	after, err := decodeEventCursor(request.Params.After)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := 0
+	if request.Params.Limit != nil {
+		if *request.Params.Limit < 1 || *request.Params.Limit > domain.MaxPageLimit {
+			return nil, apperrors.ErrInvalidLimit
+		}
+		limit = *request.Params.Limit
+	}
+
+	page, err := s.Services.Event.ListEvents(ctx, after, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return apigen.GetEvents200JSONResponse(adapter.MapEventPageToAPI(page)), nil
+}
+
+func (s *Server) GetEventsStream(
+	ctx context.Context,
+	request apigen.GetEventsStreamRequestObject,
+) (apigen.GetEventsStreamResponseObject, error) {
+	// EventSource resends the id of the last message on reconnect
+	raw := request.Params.After
+	if request.Params.LastEventID != nil && *request.Params.LastEventID != "" {
+		raw = request.Params.LastEventID
+	}
+	after, err := decodeEventCursor(raw)
+	if err != nil {
+		return nil, err
+	}
+	if after == nil {
+		after, err = s.Services.Event.LastCursor(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return apigen.GetEventsStream200TexteventStreamResponse{
+		Body: &eventStream{ctx: ctx, closing: s.closing, events: s.Services.Event, after: after},
+	}, nil
+}
+
+func decodeEventCursor(raw *string) (*domain.EventCursor, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	cursor, err := adapter.DecodeEventCursor(*raw)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
+
+// eventStream writes events as Server-Sent Events until the client goes
+// away. It is an io.WriterTo, so the generated response hands it the
+// connection instead of copying a finite body
+type eventStream struct {
+	ctx     context.Context
+	closing <-chan struct{}
+	events  service.Event
+	after   *domain.EventCursor
+}
+
+// Read is never called, io.Copy prefers WriteTo
+func (s *eventStream) Read([]byte) (int, error) {
+	return 0, io.EOF
+}
+
+func (s *eventStream) WriteTo(w io.Writer) (int64, error) {
+	rw, ok := w.(http.ResponseWriter)
+	if !ok {
+		return 0, errors.New("event stream needs an http.ResponseWriter")
+	}
+	rc := http.NewResponseController(rw)
+	// the stream outlives the server's write timeout
+	if err := rc.SetWriteDeadline(time.Time{}); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		return 0, err
+	}
+
+	var written int64
+	lastWrite := time.Now()
+	send := func(format string, args ...any) error {
+		n, err := fmt.Fprintf(w, format, args...)
+		written += int64(n)
+		if err != nil {
+			return err
+		}
+		lastWrite = time.Now()
+		return rc.Flush()
+	}
+
+	// open the stream on the client before the first event
+	if err := send(": connected\n\n"); err != nil {
+		return written, s.streamErr(err)
+	}
+
+	ticker := time.NewTicker(eventStreamPollInterval)
+	defer ticker.Stop()
+
+	for {
+		page, err := s.events.ListEvents(s.ctx, s.after, domain.MaxPageLimit)
+		if err != nil {
+			return written, s.streamErr(err)
+		}
+		for _, rec := range page.Events {
+			data, err := json.Marshal(adapter.MapEventToAPI(rec.Event))
+			if err != nil {
+				return written, fmt.Errorf("marshal event: %w", err)
+			}
+			if err := send("id: %s\ndata: %s\n\n", adapter.EncodeEventCursor(rec.Cursor), data); err != nil {
+				return written, s.streamErr(err)
+			}
+		}
+		s.after = page.NextCursor
+		// catching up: read on without waiting
+		if page.HasMore && s.ctx.Err() == nil {
+			continue
+		}
+
+		if time.Since(lastWrite) >= eventStreamHeartbeat {
+			if err := send(": heartbeat\n\n"); err != nil {
+				return written, s.streamErr(err)
+			}
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return written, nil
+		case <-s.closing:
+			return written, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// streamErr drops errors caused by the client going away, they are the
+// normal end of a stream
+func (s *eventStream) streamErr(err error) error {
+	if s.ctx.Err() != nil {
+		return nil
+	}
+	return err
+}
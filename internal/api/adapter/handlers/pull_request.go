@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
 	apigen "avito-test-applicant/internal/api/gen"
 	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/service"
@@ -16,7 +17,7 @@ func (s *Server) PostPullRequestCreate(
 	request apigen.PostPullRequestCreateRequestObject,
 ) (apigen.PostPullRequestCreateResponseObject, error) {
 	if request.Body == nil {
-		return nil, errors.New("empty body")
+		return nil, apperrors.ErrEmptyBody
 	}
 
 	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
@@ -28,19 +29,23 @@ func (s *Server) PostPullRequestCreate(
 	if err != nil {
 		return nil, err
 	}
+	if err := requireSelf(ctx, authorID); err != nil {
+		return nil, err
+	}
 
 	result, err := s.Services.PullRequest.CreateAndAssignPullRequest(
 		ctx,
 		prID,
 		request.Body.PullRequestName,
 		authorID,
+		request.Body.Draft != nil && *request.Body.Draft,
 	)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrAuthorNotFound):
-			return apigen.PostPullRequestCreate404JSONResponse(makeAPIError(apigen.NOTFOUND, err.Error())), nil
+			return apigen.PostPullRequestCreate404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
 		case errors.Is(err, service.ErrPullRequestExists):
-			return apigen.PostPullRequestCreate409JSONResponse(makeAPIError(apigen.PREXISTS, err.Error())), nil
+			return apigen.PostPullRequestCreate409JSONResponse(makeAPIError(ctx, apigen.PREXISTS, err.Error())), nil
 		default:
 			return nil, err
 		}
@@ -54,32 +59,124 @@ func (s *Server) PostPullRequestCreate(
 	return resp, nil
 }
 
+func (s *Server) GetPullRequestGet(
+	ctx context.Context,
+	request apigen.GetPullRequestGetRequestObject,
+) (apigen.GetPullRequestGetResponseObject, error) {
+	prID, err := adapter.ParseUUID(string(request.Params.PullRequestId))
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.GetPullRequestById(ctx, prID)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetPullRequestGet404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.GetPullRequestGet200JSONResponse(adapter.MapPullRequestWithReviewersToAPI(pr)), nil
+}
+
+func (s *Server) GetPullRequestHistory(
+	ctx context.Context,
+	request apigen.GetPullRequestHistoryRequestObject,
+) (apigen.GetPullRequestHistoryResponseObject, error) {
+	prID, err := adapter.ParseUUID(string(request.Params.PullRequestId))
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := s.Services.PullRequest.GetHistory(ctx, prID)
+	if err != nil {
+		if errors.Is(err, service.ErrPullRequestNotFound) {
+			return apigen.GetPullRequestHistory404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	resp := apigen.GetPullRequestHistory200JSONResponse{
+		PullRequestId: prID.String(),
+		Events:        make([]apigen.ReviewerEvent, len(events)),
+	}
+	for i, e := range events {
+		resp.Events[i] = adapter.MapReviewerEventToAPI(e)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) GetPullRequestList(
+	ctx context.Context,
+	request apigen.GetPullRequestListRequestObject,
+) (apigen.GetPullRequestListResponseObject, error) {
+	filter, err := adapter.MapAPIListParamsToDomainFilter(request.Params)
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := s.Services.PullRequest.ListPullRequests(ctx, request.Params.TeamName, filter)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetPullRequestList404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	prs := make([]apigen.PullRequestShort, len(page.PullRequests))
+	for i, pr := range page.PullRequests {
+		prs[i] = adapter.MapPullRequestShortToAPI(pr)
+	}
+
+	resp := apigen.GetPullRequestList200JSONResponse{
+		PullRequests: prs,
+	}
+	if page.NextCursor != nil {
+		cursor := adapter.EncodeCursor(*page.NextCursor)
+		resp.NextCursor = &cursor
+	}
+
+	return resp, nil
+}
+
 func (s *Server) PostPullRequestMerge(
 	ctx context.Context,
 	request apigen.PostPullRequestMergeRequestObject,
 ) (apigen.PostPullRequestMergeResponseObject, error) {
 	if request.Body == nil {
-		return nil, errors.New("empty body")
+		return nil, apperrors.ErrEmptyBody
 	}
 
 	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
 
-	pr, err := s.Services.PullRequest.SetMerged(ctx, prID)
+	force := request.Body.Force != nil && *request.Body.Force
+	if force && !requireAdmin(ctx) {
+		return apigen.PostPullRequestMerge403JSONResponse(
+			makeAPIError(ctx, apigen.FORBIDDEN, "force merge requires admin role"),
+		), nil
+	}
+
+	pr, err := s.Services.PullRequest.SetMerged(ctx, prID, force)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrPullRequestNotFound):
-			return apigen.PostPullRequestMerge404JSONResponse(makeAPIError(apigen.NOTFOUND, err.Error())), nil
+			return apigen.PostPullRequestMerge404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrNotApproved):
+			return apigen.PostPullRequestMerge409JSONResponse(makeAPIError(ctx, apigen.NOTAPPROVED, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			return apigen.PostPullRequestMerge409JSONResponse(makeAPIError(ctx, apigen.INVALIDSTATUSTRANSITION, err.Error())), nil
 		default:
 			return nil, err
 		}
 	}
-	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(domain.PullRequestWithReviewers{
-		PullRequest: pr,
-		Reviewers:   []uuid.UUID{},
-	})
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
 	resp := apigen.PostPullRequestMerge200JSONResponse{
 		Pr: &apiPullRequest,
 	}
@@ -87,42 +184,297 @@ func (s *Server) PostPullRequestMerge(
 	return resp, nil
 }
 
+func (s *Server) PostPullRequestClose(
+	ctx context.Context,
+	request apigen.PostPullRequestCloseRequestObject,
+) (apigen.PostPullRequestCloseResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.ClosePullRequest(ctx, prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			return apigen.PostPullRequestClose404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			return apigen.PostPullRequestClose409JSONResponse(makeAPIError(ctx, apigen.INVALIDSTATUSTRANSITION, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
+	return apigen.PostPullRequestClose200JSONResponse{Pr: &apiPullRequest}, nil
+}
+
+func (s *Server) PostPullRequestReopen(
+	ctx context.Context,
+	request apigen.PostPullRequestReopenRequestObject,
+) (apigen.PostPullRequestReopenResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.ReopenPullRequest(ctx, prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			return apigen.PostPullRequestReopen404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			return apigen.PostPullRequestReopen409JSONResponse(makeAPIError(ctx, apigen.INVALIDSTATUSTRANSITION, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
+	return apigen.PostPullRequestReopen200JSONResponse{Pr: &apiPullRequest}, nil
+}
+
+func (s *Server) PostPullRequestReady(
+	ctx context.Context,
+	request apigen.PostPullRequestReadyRequestObject,
+) (apigen.PostPullRequestReadyResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.MarkReady(ctx, prID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			return apigen.PostPullRequestReady404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidStatusTransition):
+			return apigen.PostPullRequestReady409JSONResponse(makeAPIError(ctx, apigen.INVALIDSTATUSTRANSITION, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
+	return apigen.PostPullRequestReady200JSONResponse{Pr: &apiPullRequest}, nil
+}
+
+func (s *Server) PostPullRequestAddReviewer(
+	ctx context.Context,
+	request apigen.PostPullRequestAddReviewerRequestObject,
+) (apigen.PostPullRequestAddReviewerResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	userID, err := adapter.ParseUUID(request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.AddReviewer(ctx, prID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound), errors.Is(err, service.ErrUserNotFound):
+			return apigen.PostPullRequestAddReviewer404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestMerged):
+			return apigen.PostPullRequestAddReviewer409JSONResponse(makeAPIError(ctx, apigen.PRMERGED, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestNotOpen):
+			return apigen.PostPullRequestAddReviewer409JSONResponse(makeAPIError(ctx, apigen.PRNOTOPEN, err.Error())), nil
+		case errors.Is(err, service.ErrAlreadyAssigned):
+			return apigen.PostPullRequestAddReviewer409JSONResponse(makeAPIError(ctx, apigen.ALREADYASSIGNED, err.Error())), nil
+		case errors.Is(err, service.ErrTooManyReviewers):
+			return apigen.PostPullRequestAddReviewer409JSONResponse(makeAPIError(ctx, apigen.TOOMANYREVIEWERS, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidReviewer):
+			return apigen.PostPullRequestAddReviewer409JSONResponse(makeAPIError(ctx, apigen.INVALIDREVIEWER, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
+	return apigen.PostPullRequestAddReviewer200JSONResponse{Pr: &apiPullRequest}, nil
+}
+
+func (s *Server) PostPullRequestRemoveReviewer(
+	ctx context.Context,
+	request apigen.PostPullRequestRemoveReviewerRequestObject,
+) (apigen.PostPullRequestRemoveReviewerResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
+
+	userID, err := adapter.ParseUUID(request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	pr, err := s.Services.PullRequest.RemoveReviewer(ctx, prID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			return apigen.PostPullRequestRemoveReviewer404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestMerged):
+			return apigen.PostPullRequestRemoveReviewer409JSONResponse(makeAPIError(ctx, apigen.PRMERGED, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestNotOpen):
+			return apigen.PostPullRequestRemoveReviewer409JSONResponse(makeAPIError(ctx, apigen.PRNOTOPEN, err.Error())), nil
+		case errors.Is(err, service.ErrNotAssigned):
+			return apigen.PostPullRequestRemoveReviewer409JSONResponse(makeAPIError(ctx, apigen.NOTASSIGNED, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(pr)
+	return apigen.PostPullRequestRemoveReviewer200JSONResponse{Pr: &apiPullRequest}, nil
+}
+
 func (s *Server) PostPullRequestReassign(
 	ctx context.Context,
 	request apigen.PostPullRequestReassignRequestObject,
 ) (apigen.PostPullRequestReassignResponseObject, error) {
 	if request.Body == nil {
-		return nil, errors.New("empty body")
+		return nil, apperrors.ErrEmptyBody
 	}
 
 	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.requireTeamOfPullRequest(ctx, prID); err != nil {
+		return nil, err
+	}
 
 	oldID, err := adapter.ParseUUID(request.Body.OldUserId)
 	if err != nil {
 		return nil, err
 	}
 
-	result, err := s.Services.PullRequest.Reassign(ctx, prID, oldID)
+	var newID *uuid.UUID
+	if request.Body.NewUserId != nil {
+		id, err := adapter.ParseUUID(*request.Body.NewUserId)
+		if err != nil {
+			return nil, err
+		}
+		newID = &id
+	}
+
+	result, err := s.Services.PullRequest.Reassign(ctx, prID, oldID, newID)
 	if err != nil {
 		switch {
 		case errors.Is(err, service.ErrPullRequestNotFound):
-			return apigen.PostPullRequestReassign404JSONResponse(makeAPIError(apigen.NOTFOUND, err.Error())), nil
+			return apigen.PostPullRequestReassign404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
 		case errors.Is(err, service.ErrPullRequestMerged):
-			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(apigen.PRMERGED, err.Error())), nil
-		case errors.Is(err, service.ErrUserNotFound):
-			return apigen.PostPullRequestReassign404JSONResponse(makeAPIError(apigen.NOTFOUND, err.Error())), nil
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.PRMERGED, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestNotOpen):
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.PRNOTOPEN, err.Error())), nil
+		case errors.Is(err, service.ErrNotAssigned):
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.NOTASSIGNED, err.Error())), nil
 		case errors.Is(err, service.ErrNoCandidate):
-			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(apigen.NOCANDIDATE, err.Error())), nil
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.NOCANDIDATE, err.Error())), nil
+		case errors.Is(err, service.ErrAlreadyAssigned):
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.ALREADYASSIGNED, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidReviewer):
+			return apigen.PostPullRequestReassign409JSONResponse(makeAPIError(ctx, apigen.INVALIDREVIEWER, err.Error())), nil
+		case errors.Is(err, service.ErrUserNotFound):
+			return apigen.PostPullRequestReassign404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
 		default:
 			return nil, err
 		}
 	}
 
 	resp := apigen.PostPullRequestReassign200JSONResponse{
-		Pr: adapter.MapPullRequestWithReviewersToAPI(result),
+		Pr:         adapter.MapPullRequestWithReviewersToAPI(result.PullRequestWithReviewers),
+		ReplacedBy: result.ReplacedBy.String(),
+	}
+
+	return resp, nil
+}
+
+func (s *Server) PostPullRequestReview(
+	ctx context.Context,
+	request apigen.PostPullRequestReviewRequestObject,
+) (apigen.PostPullRequestReviewResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	prID, err := adapter.ParseUUID(request.Body.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	reviewerID, err := adapter.ParseUUID(request.Body.ReviewerId)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireSelf(ctx, reviewerID); err != nil {
+		return nil, err
+	}
+
+	result, err := s.Services.PullRequest.SubmitReview(
+		ctx,
+		prID,
+		reviewerID,
+		domain.ReviewState(request.Body.State),
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidReviewState):
+			return apigen.PostPullRequestReview400JSONResponse(makeAPIError(ctx, apigen.INVALIDREVIEWSTATE, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			return apigen.PostPullRequestReview404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestMerged):
+			return apigen.PostPullRequestReview409JSONResponse(makeAPIError(ctx, apigen.PRMERGED, err.Error())), nil
+		case errors.Is(err, service.ErrPullRequestNotOpen):
+			return apigen.PostPullRequestReview409JSONResponse(makeAPIError(ctx, apigen.PRNOTOPEN, err.Error())), nil
+		case errors.Is(err, service.ErrNotAssigned):
+			return apigen.PostPullRequestReview409JSONResponse(makeAPIError(ctx, apigen.NOTASSIGNED, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	apiPullRequest := adapter.MapPullRequestWithReviewersToAPI(result)
+	resp := apigen.PostPullRequestReview200JSONResponse{
+		Pr: apiPullRequest,
 	}
 
 	return resp, nil
@@ -136,10 +488,19 @@ func (s *Server) GetUsersGetReview(
 	if err != nil {
 		return nil, err
 	}
+	if err := requireSelf(ctx, userID); err != nil {
+		return nil, err
+	}
 
-	prs, err := s.Services.PullRequest.GetAssignedReviewsByUserId(ctx, userID)
+	var state *domain.ReviewState
+	if request.Params.ReviewState != nil {
+		st := domain.ReviewState(*request.Params.ReviewState)
+		state = &st
+	}
+
+	prs, err := s.Services.PullRequest.GetAssignedReviewsByUserId(ctx, userID, state)
 	if err != nil {
-		return nil, errors.New("internal error")
+		return nil, err
 	}
 
 	out := make([]apigen.PullRequestShort, len(prs))
@@ -1,9 +1,17 @@
 package handlers
 
-import "avito-test-applicant/internal/service"
+import (
+	"avito-test-applicant/internal/service"
+	"sync"
+)
 
 type Server struct {
 	Services *service.Services
+
+	// closing is closed on shutdown to end event streams, which otherwise
+	// last until the client goes away
+	closing   chan struct{}
+	closeOnce sync.Once
 }
 
 func NewServer(
@@ -11,5 +19,12 @@ func NewServer(
 ) *Server {
 	return &Server{
 		Services: services,
+		closing:  make(chan struct{}),
 	}
 }
+
+// CloseStreams ends open event streams; clients reconnect and resume from
+// the last event they got
+func (s *Server) CloseStreams() {
+	s.closeOnce.Do(func() { close(s.closing) })
+}
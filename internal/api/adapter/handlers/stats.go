@@ -0,0 +1,41 @@
+package handlers
+
+import (
+	"avito-test-applicant/internal/api/adapter"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/service"
+	"context"
+	"errors"
+)
+
+func (s *Server) GetStats(
+	ctx context.Context,
+	request apigen.GetStatsRequestObject,
+) (apigen.GetStatsResponseObject, error) {
+	stats, err := s.Services.Stats.GetStats(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return apigen.GetStats200JSONResponse(adapter.MapDomainStatsToAPI(stats)), nil
+}
+
+func (s *Server) GetStatsTeam(
+	ctx context.Context,
+	request apigen.GetStatsTeamRequestObject,
+) (apigen.GetStatsTeamResponseObject, error) {
+	teamName := string(request.Params.TeamName)
+
+	stats, err := s.Services.Stats.GetTeamStats(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetStatsTeam404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	response := adapter.MapDomainStatsToAPI(stats)
+	response.TeamName = &teamName
+
+	return apigen.GetStatsTeam200JSONResponse(response), nil
+}
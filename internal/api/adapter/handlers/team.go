@@ -2,10 +2,14 @@ package handlers
 
 import (
 	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
 	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/service"
 	"context"
 	"errors"
+
+	"github.com/google/uuid"
 )
 
 func (s *Server) PostTeamAdd(
@@ -13,7 +17,7 @@ func (s *Server) PostTeamAdd(
 	request apigen.PostTeamAddRequestObject,
 ) (apigen.PostTeamAddResponseObject, error) {
 	if request.Body == nil {
-		return nil, errors.New("request body is empty")
+		return nil, apperrors.ErrEmptyBody
 	}
 
 	domainUsers, err := adapter.MapAPIMembersToDomainUsersInput(request.Body.Members)
@@ -21,16 +25,50 @@ func (s *Server) PostTeamAdd(
 		return nil, err
 	}
 
-	teamWithUsers, err := s.Services.Team.CreateTeamWithUsers(ctx, request.Body.TeamName, domainUsers)
+	reviewersRequired := domain.DefaultReviewersRequired
+	if request.Body.ReviewersRequired != nil {
+		reviewersRequired = *request.Body.ReviewersRequired
+	}
+
+	moveExisting := request.Body.MoveExisting != nil && *request.Body.MoveExisting
+
+	creation, err := s.Services.Team.CreateTeamWithUsers(
+		ctx,
+		request.Body.TeamName,
+		reviewersRequired,
+		domainUsers,
+		moveExisting,
+	)
 	if err != nil {
-		if errors.Is(err, service.ErrTeamAlreadyExists) {
-			return apigen.PostTeamAdd400JSONResponse(makeAPIError(apigen.TEAMEXISTS, err.Error())), nil
+		var conflict *service.UsersInOtherTeamError
+		switch {
+		case errors.Is(err, service.ErrTeamAlreadyExists):
+			return apigen.PostTeamAdd400JSONResponse(makeAPIError(ctx, apigen.TEAMEXISTS, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidReviewersRequired):
+			return apigen.PostTeamAdd400JSONResponse(makeAPIError(ctx, apigen.INVALIDREVIEWERSREQUIRED, err.Error())), nil
+		case errors.As(err, &conflict):
+			users := make([]apigen.User, len(conflict.Users))
+			for i, u := range conflict.Users {
+				users[i] = adapter.MapDomainUserWithTeamNameToAPI(u)
+			}
+			apiErr := makeAPIError(ctx, apigen.USERINOTHERTEAM, err.Error())
+			apiErr.Error.Users = &users
+			return apigen.PostTeamAdd409JSONResponse(apiErr), nil
+		case errors.Is(err, service.ErrUsernameTaken):
+			return apigen.PostTeamAdd409JSONResponse(makeAPIError(ctx, apigen.USERNAMETAKEN, err.Error())), nil
+		default:
+			return nil, err
 		}
-		return nil, err
+	}
+
+	moved := make([]apigen.MembershipChange, len(creation.Moved))
+	for i, c := range creation.Moved {
+		moved[i] = adapter.MapDomainMembershipChangeToAPI(c)
 	}
 
 	response := apigen.PostTeamAdd201JSONResponse{
-		Team: adapter.MapDomainTeamWithUsersToAPITeam(teamWithUsers),
+		Team:  adapter.MapDomainTeamWithUsersToAPITeam(creation.TeamWithUsers),
+		Moved: &moved,
 	}
 
 	return response, nil
@@ -45,15 +83,292 @@ func (s *Server) GetTeamGet(
 	teamWithUsers, err := s.Services.Team.GetTeamByName(ctx, teamName)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
-			return apigen.GetTeamGet404JSONResponse(makeAPIError(apigen.NOTFOUND, err.Error())), nil
+			return apigen.GetTeamGet404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
 		}
 		return nil, err
 	}
 
-	response := apigen.GetTeamGet200JSONResponse{
-		TeamName: teamWithUsers.Team.TeamName,
-		Members:  adapter.MapDomainUsersToAPIMembers(teamWithUsers.Users),
-	}
+	response := apigen.GetTeamGet200JSONResponse(*adapter.MapDomainTeamWithUsersToAPITeam(teamWithUsers))
 
 	return response, nil
 }
+
+func (s *Server) GetTeamSettings(
+	ctx context.Context,
+	request apigen.GetTeamSettingsRequestObject,
+) (apigen.GetTeamSettingsResponseObject, error) {
+	teamName := string(request.Params.TeamName)
+
+	settings, err := s.Services.Team.GetTeamSettings(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetTeamSettings404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.GetTeamSettings200JSONResponse(adapter.MapDomainTeamSettingsToAPI(teamName, settings)), nil
+}
+
+func (s *Server) PostTeamSettings(
+	ctx context.Context,
+	request apigen.PostTeamSettingsRequestObject,
+) (apigen.PostTeamSettingsResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	settings, err := s.Services.Team.UpdateTeamSettings(
+		ctx,
+		request.Body.TeamName,
+		domain.SelectionStrategy(request.Body.SelectionStrategy),
+		request.Body.ReviewersRequired,
+	)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUnknownSelectionStrategy):
+			return apigen.PostTeamSettings400JSONResponse(makeAPIError(ctx, apigen.INVALIDSTRATEGY, err.Error())), nil
+		case errors.Is(err, service.ErrInvalidReviewersRequired):
+			return apigen.PostTeamSettings400JSONResponse(makeAPIError(ctx, apigen.INVALIDREVIEWERSREQUIRED, err.Error())), nil
+		case errors.Is(err, service.ErrNotFound):
+			return apigen.PostTeamSettings404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamSettings200JSONResponse(adapter.MapDomainTeamSettingsToAPI(request.Body.TeamName, settings)), nil
+}
+
+func (s *Server) GetTeamMergePolicy(
+	ctx context.Context,
+	request apigen.GetTeamMergePolicyRequestObject,
+) (apigen.GetTeamMergePolicyResponseObject, error) {
+	teamName := string(request.Params.TeamName)
+
+	policy, err := s.Services.Team.GetMergePolicy(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetTeamMergePolicy404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.GetTeamMergePolicy200JSONResponse(adapter.MapDomainMergePolicyToAPI(teamName, policy)), nil
+}
+
+func (s *Server) PostTeamMergePolicy(
+	ctx context.Context,
+	request apigen.PostTeamMergePolicyRequestObject,
+) (apigen.PostTeamMergePolicyResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	policy := domain.MergePolicy{
+		RequiredApprovals:   request.Body.RequiredApprovals,
+		RequireLeadApproval: request.Body.RequireLeadApproval,
+	}
+	if request.Body.LeadUserId != nil {
+		leadId, err := adapter.ParseUUID(*request.Body.LeadUserId)
+		if err != nil {
+			return nil, err
+		}
+		policy.LeadUserId = &leadId
+	}
+
+	policy, err := s.Services.Team.UpdateMergePolicy(ctx, request.Body.TeamName, policy)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidMergePolicy):
+			return apigen.PostTeamMergePolicy400JSONResponse(makeAPIError(ctx, apigen.INVALIDMERGEPOLICY, err.Error())), nil
+		case errors.Is(err, service.ErrNotFound), errors.Is(err, service.ErrUserNotInTeam):
+			return apigen.PostTeamMergePolicy404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamMergePolicy200JSONResponse(adapter.MapDomainMergePolicyToAPI(request.Body.TeamName, policy)), nil
+}
+
+func (s *Server) GetTeamFallbacks(
+	ctx context.Context,
+	request apigen.GetTeamFallbacksRequestObject,
+) (apigen.GetTeamFallbacksResponseObject, error) {
+	fallbacks, err := s.Services.Team.GetFallbackTeams(ctx, string(request.Params.TeamName))
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetTeamFallbacks404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.GetTeamFallbacks200JSONResponse(adapter.MapDomainTeamFallbacksToAPI(fallbacks)), nil
+}
+
+func (s *Server) PostTeamFallbacks(
+	ctx context.Context,
+	request apigen.PostTeamFallbacksRequestObject,
+) (apigen.PostTeamFallbacksResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	fallbacks, err := s.Services.Team.SetFallbackTeams(ctx, request.Body.TeamName, request.Body.FallbackTeams)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidFallbackTeam):
+			return apigen.PostTeamFallbacks400JSONResponse(makeAPIError(ctx, apigen.INVALIDFALLBACKTEAM, err.Error())), nil
+		case errors.Is(err, service.ErrNotFound):
+			return apigen.PostTeamFallbacks404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamFallbacks200JSONResponse(adapter.MapDomainTeamFallbacksToAPI(fallbacks)), nil
+}
+
+func (s *Server) PostTeamDeactivateUsers(
+	ctx context.Context,
+	request apigen.PostTeamDeactivateUsersRequestObject,
+) (apigen.PostTeamDeactivateUsersResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	var userIds []uuid.UUID
+	all := false
+	if v, err := request.Body.UserIds.AsAllUsers(); err == nil && v == apigen.All {
+		all = true
+	} else {
+		list, err := request.Body.UserIds.AsUserIdList()
+		if err != nil {
+			return nil, err
+		}
+		userIds = make([]uuid.UUID, len(list))
+		for i, raw := range list {
+			userIds[i], err = adapter.ParseUUID(raw)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	deactivation, err := s.Services.Team.DeactivateUsers(ctx, request.Body.TeamName, userIds, all)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound), errors.Is(err, service.ErrUserNotInTeam):
+			return apigen.PostTeamDeactivateUsers404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamDeactivateUsers200JSONResponse(adapter.MapDomainTeamDeactivationToAPI(deactivation)), nil
+}
+
+func (s *Server) PostTeamAddMembers(
+	ctx context.Context,
+	request apigen.PostTeamAddMembersRequestObject,
+) (apigen.PostTeamAddMembersResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	members, err := adapter.MapAPIMembersToDomainUsersInput(request.Body.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	teamWithUsers, err := s.Services.Team.AddMembers(ctx, request.Body.TeamName, members)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return apigen.PostTeamAddMembers404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrUserAlreadyExists):
+			return apigen.PostTeamAddMembers409JSONResponse(makeAPIError(ctx, apigen.USEREXISTS, err.Error())), nil
+		case errors.Is(err, service.ErrUsernameTaken):
+			return apigen.PostTeamAddMembers409JSONResponse(makeAPIError(ctx, apigen.USERNAMETAKEN, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamAddMembers200JSONResponse(*adapter.MapDomainTeamWithUsersToAPITeam(teamWithUsers)), nil
+}
+
+func (s *Server) PostTeamRemoveMember(
+	ctx context.Context,
+	request apigen.PostTeamRemoveMemberRequestObject,
+) (apigen.PostTeamRemoveMemberResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	userId, err := adapter.ParseUUID(request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamByName(ctx, request.Body.TeamName); err != nil {
+		return nil, err
+	}
+
+	change, err := s.Services.Team.RemoveMember(ctx, request.Body.TeamName, userId)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound),
+			errors.Is(err, service.ErrUserNotFound),
+			errors.Is(err, service.ErrUserNotInTeam):
+			return apigen.PostTeamRemoveMember404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamRemoveMember200JSONResponse(adapter.MapDomainMembershipChangeToAPI(change)), nil
+}
+
+func (s *Server) PostTeamRename(
+	ctx context.Context,
+	request apigen.PostTeamRenameRequestObject,
+) (apigen.PostTeamRenameResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	teamWithUsers, err := s.Services.Team.RenameTeam(ctx, request.Body.TeamName, request.Body.NewTeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound):
+			return apigen.PostTeamRename404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrTeamAlreadyExists):
+			return apigen.PostTeamRename409JSONResponse(makeAPIError(ctx, apigen.TEAMEXISTS, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostTeamRename200JSONResponse(*adapter.MapDomainTeamWithUsersToAPITeam(teamWithUsers)), nil
+}
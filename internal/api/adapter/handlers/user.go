@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
 	apigen "avito-test-applicant/internal/api/gen"
 	"avito-test-applicant/internal/service"
 	"context"
@@ -13,32 +14,107 @@ func (s *Server) PostUsersSetIsActive(
 	request apigen.PostUsersSetIsActiveRequestObject,
 ) (apigen.PostUsersSetIsActiveResponseObject, error) {
 	if request.Body == nil {
-		return nil, errors.New("request body is empty")
+		return nil, apperrors.ErrEmptyBody
 	}
 
 	userId, err := adapter.ParseUUID(request.Body.UserId)
 	if err != nil {
 		return nil, err
 	}
+	if err := s.requireTeamOfUser(ctx, userId); err != nil {
+		return nil, err
+	}
 
-	updatedUser, err := s.Services.User.SetIsActive(ctx, userId, request.Body.IsActive)
+	change, err := s.Services.User.SetIsActive(ctx, userId, request.Body.IsActive)
 	if err != nil {
 		if errors.Is(err, service.ErrNotFound) {
 			return apigen.PostUsersSetIsActive404JSONResponse(
-				makeAPIError(apigen.NOTFOUND, "user not found"),
+				makeAPIError(ctx, apigen.NOTFOUND, "user not found"),
 			), nil
 		}
 		return nil, err
 	}
 
+	reassigned := make([]apigen.ReviewReassignment, len(change.Reassigned))
+	for i, r := range change.Reassigned {
+		reassigned[i] = adapter.MapReviewReassignmentToAPI(r)
+	}
+	notReassigned := make([]string, len(change.NotReassigned))
+	for i, id := range change.NotReassigned {
+		notReassigned[i] = id.String()
+	}
+
 	response := apigen.PostUsersSetIsActive200JSONResponse{
 		User: &apigen.User{
-			UserId:   updatedUser.UserId.String(),
-			Username: updatedUser.Username,
-			TeamName: updatedUser.TeamName,
-			IsActive: updatedUser.IsActive,
+			UserId:   change.User.UserId.String(),
+			Username: change.User.Username,
+			TeamName: change.User.TeamName,
+			IsActive: change.User.IsActive,
 		},
+		Reassigned:    reassigned,
+		NotReassigned: notReassigned,
 	}
 
 	return response, nil
 }
+
+func (s *Server) PostUsersMoveTeam(
+	ctx context.Context,
+	request apigen.PostUsersMoveTeamRequestObject,
+) (apigen.PostUsersMoveTeamResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	userId, err := adapter.ParseUUID(request.Body.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := s.Services.Team.MoveUser(ctx, userId, request.Body.TeamName)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrNotFound), errors.Is(err, service.ErrUserNotFound):
+			return apigen.PostUsersMoveTeam404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		case errors.Is(err, service.ErrUsernameTaken):
+			return apigen.PostUsersMoveTeam409JSONResponse(makeAPIError(ctx, apigen.USERNAMETAKEN, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostUsersMoveTeam200JSONResponse(adapter.MapDomainMembershipChangeToAPI(change)), nil
+}
+
+func (s *Server) GetUsersTeamHistory(
+	ctx context.Context,
+	request apigen.GetUsersTeamHistoryRequestObject,
+) (apigen.GetUsersTeamHistoryResponseObject, error) {
+	userId, err := adapter.ParseUUID(request.Params.UserId)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireTeamOfUser(ctx, userId); err != nil {
+		return nil, err
+	}
+
+	events, err := s.Services.User.GetTeamHistory(ctx, userId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.GetUsersTeamHistory404JSONResponse(
+				makeAPIError(ctx, apigen.NOTFOUND, "user not found"),
+			), nil
+		}
+		return nil, err
+	}
+
+	resp := apigen.GetUsersTeamHistory200JSONResponse{
+		UserId: userId.String(),
+		Events: make([]apigen.MembershipEvent, len(events)),
+	}
+	for i, e := range events {
+		resp.Events[i] = adapter.MapMembershipEventToAPI(e)
+	}
+
+	return resp, nil
+}
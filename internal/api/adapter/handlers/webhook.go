@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"context"
+	"errors"
+)
+
+func (s *Server) GetWebhooks(
+	ctx context.Context,
+	request apigen.GetWebhooksRequestObject,
+) (apigen.GetWebhooksResponseObject, error) {
+	webhooks, err := s.Services.Webhook.ListWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]apigen.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		out[i] = adapter.MapWebhookToAPI(w)
+	}
+	return apigen.GetWebhooks200JSONResponse{Webhooks: out}, nil
+}
+
+func (s *Server) PostWebhooks(
+	ctx context.Context,
+	request apigen.PostWebhooksRequestObject,
+) (apigen.PostWebhooksResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	var eventTypes []domain.EventType
+	if types := adapter.MapAPIEventTypes(request.Body.EventTypes); types != nil {
+		eventTypes = *types
+	}
+
+	secret, webhook, err := s.Services.Webhook.CreateWebhook(ctx, request.Body.Url, eventTypes)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidWebhook) {
+			return apigen.PostWebhooks400JSONResponse(makeAPIError(ctx, apigen.INVALIDWEBHOOK, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.PostWebhooks201JSONResponse{
+		Secret:  secret,
+		Webhook: adapter.MapWebhookToAPI(webhook),
+	}, nil
+}
+
+func (s *Server) PostWebhooksUpdate(
+	ctx context.Context,
+	request apigen.PostWebhooksUpdateRequestObject,
+) (apigen.PostWebhooksUpdateResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	webhookId, err := adapter.ParseUUID(request.Body.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.Services.Webhook.UpdateWebhook(ctx, webhookId, domain.WebhookUpdate{
+		URL:        request.Body.Url,
+		EventTypes: adapter.MapAPIEventTypes(request.Body.EventTypes),
+		IsActive:   request.Body.IsActive,
+	})
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrInvalidWebhook):
+			return apigen.PostWebhooksUpdate400JSONResponse(makeAPIError(ctx, apigen.INVALIDWEBHOOK, err.Error())), nil
+		case errors.Is(err, service.ErrNotFound):
+			return apigen.PostWebhooksUpdate404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		default:
+			return nil, err
+		}
+	}
+
+	return apigen.PostWebhooksUpdate200JSONResponse{
+		Webhook: adapter.MapWebhookToAPI(webhook),
+	}, nil
+}
+
+func (s *Server) PostWebhooksDelete(
+	ctx context.Context,
+	request apigen.PostWebhooksDeleteRequestObject,
+) (apigen.PostWebhooksDeleteResponseObject, error) {
+	if request.Body == nil {
+		return nil, apperrors.ErrEmptyBody
+	}
+
+	webhookId, err := adapter.ParseUUID(request.Body.WebhookId)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.Services.Webhook.DeleteWebhook(ctx, webhookId)
+	if err != nil {
+		if errors.Is(err, service.ErrNotFound) {
+			return apigen.PostWebhooksDelete404JSONResponse(makeAPIError(ctx, apigen.NOTFOUND, err.Error())), nil
+		}
+		return nil, err
+	}
+
+	return apigen.PostWebhooksDelete200JSONResponse{
+		Webhook: adapter.MapWebhookToAPI(webhook),
+	}, nil
+}
@@ -40,9 +40,118 @@ func MapDomainUsersToAPIMembers(users []domain.User) []apigen.TeamMember {
 }
 
 func MapDomainTeamWithUsersToAPITeam(t domain.TeamWithUsers) *apigen.Team {
+	reviewersRequired := t.Team.ReviewersRequired
 	return &apigen.Team{
-		TeamName: t.Team.TeamName,
-		Members:  MapDomainUsersToAPIMembers(t.Users),
+		TeamName:          t.Team.TeamName,
+		ReviewersRequired: &reviewersRequired,
+		Members:           MapDomainUsersToAPIMembers(t.Users),
+	}
+}
+
+func MapDomainTeamSettingsToAPI(teamName string, s domain.TeamSettings) apigen.TeamSettings {
+	reviewersRequired := s.ReviewersRequired
+	return apigen.TeamSettings{
+		TeamName:          teamName,
+		SelectionStrategy: apigen.SelectionStrategy(s.SelectionStrategy),
+		ReviewersRequired: &reviewersRequired,
+	}
+}
+
+func MapDomainMergePolicyToAPI(teamName string, p domain.MergePolicy) apigen.MergePolicy {
+	res := apigen.MergePolicy{
+		TeamName:            teamName,
+		RequiredApprovals:   p.RequiredApprovals,
+		RequireLeadApproval: p.RequireLeadApproval,
+	}
+	if p.LeadUserId != nil {
+		lead := p.LeadUserId.String()
+		res.LeadUserId = &lead
+	}
+	return res
+}
+
+func MapDomainTeamFallbacksToAPI(f domain.TeamFallbacks) apigen.TeamFallbacks {
+	names := make([]string, len(f.FallbackTeams))
+	for i, t := range f.FallbackTeams {
+		names[i] = t.TeamName
+	}
+	return apigen.TeamFallbacks{
+		TeamName:      f.Team.TeamName,
+		FallbackTeams: names,
+	}
+}
+
+func MapDomainTeamDeactivationToAPI(d domain.TeamDeactivation) apigen.TeamDeactivation {
+	deactivated := make([]string, len(d.Deactivated))
+	for i, id := range d.Deactivated {
+		deactivated[i] = id.String()
+	}
+
+	return apigen.TeamDeactivation{
+		TeamName:    d.TeamName,
+		Deactivated: deactivated,
+		Reviews:     mapReviewHandoversToAPI(d.Reviews),
+	}
+}
+
+func MapDomainMembershipChangeToAPI(c domain.MembershipChange) apigen.MembershipChange {
+	return apigen.MembershipChange{
+		User:    MapDomainUserWithTeamNameToAPI(c.User),
+		Reviews: mapReviewHandoversToAPI(c.Reviews),
+	}
+}
+
+func MapDomainUserWithTeamNameToAPI(u domain.UserWithTeamName) apigen.User {
+	return apigen.User{
+		UserId:   u.UserId.String(),
+		Username: u.Username,
+		TeamName: u.TeamName,
+		IsActive: u.IsActive,
+	}
+}
+
+func mapReviewHandoversToAPI(handovers []domain.ReviewHandover) []apigen.ReviewHandover {
+	reviews := make([]apigen.ReviewHandover, len(handovers))
+	for i, h := range handovers {
+		reviews[i] = apigen.ReviewHandover{
+			PullRequestId: h.PullRequestId.String(),
+			UserId:        h.UserId.String(),
+		}
+		if h.ReplacedBy != nil {
+			replacedBy := h.ReplacedBy.String()
+			reviews[i].ReplacedBy = &replacedBy
+		}
+	}
+	return reviews
+}
+
+func MapDomainStatsToAPI(s domain.Stats) apigen.Stats {
+	reviewers := make([]apigen.ReviewerStats, len(s.Reviewers))
+	for i, r := range s.Reviewers {
+		reviewers[i] = apigen.ReviewerStats{
+			UserId:           r.UserId.String(),
+			Username:         r.Username,
+			TotalAssignments: r.TotalAssignments,
+			OpenReviews:      r.OpenReviews,
+			MergedReviews:    r.MergedReviews,
+		}
+	}
+
+	authors := make([]apigen.AuthorStats, len(s.Authors))
+	for i, a := range s.Authors {
+		authors[i] = apigen.AuthorStats{
+			UserId:       a.UserId.String(),
+			Username:     a.Username,
+			PullRequests: a.PullRequests,
+		}
+	}
+
+	return apigen.Stats{
+		Reviewers:                    reviewers,
+		Authors:                      authors,
+		TotalPullRequests:            s.TotalPullRequests,
+		AvgReviewersPerPullRequest:   s.AvgReviewersPerPullRequest,
+		PullRequestsWithoutReviewers: s.PullRequestsWithoutReviewers,
 	}
 }
 
@@ -73,12 +182,77 @@ func MapAPIMembersToDomainUsersInput(members []apigen.TeamMember) ([]domain.User
 }
 
 func MapPullRequestShortToAPI(pr domain.PullRequestShort) apigen.PullRequestShort {
-	return apigen.PullRequestShort{
+	out := apigen.PullRequestShort{
 		PullRequestId:   pr.PullRequestId.String(),
 		AuthorId:        pr.AuthorId.String(),
 		PullRequestName: pr.PullRequestName,
 		Status:          apigen.PullRequestShortStatus(pr.Status),
 	}
+	if pr.ReviewState != nil {
+		state := apigen.ReviewState(*pr.ReviewState)
+		out.ReviewState = &state
+	}
+	return out
+}
+
+func MapReviewToAPI(r domain.Review) apigen.Review {
+	return apigen.Review{
+		UserId:     r.UserId.String(),
+		State:      apigen.ReviewState(r.State),
+		AssignedAt: r.AssignedAt,
+		ReviewedAt: r.ReviewedAt,
+	}
+}
+
+func MapReviewerEventToAPI(e domain.ReviewerEvent) apigen.ReviewerEvent {
+	res := apigen.ReviewerEvent{
+		Id:        e.Id,
+		Type:      apigen.ReviewerEventType(e.Type),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.UserId != nil {
+		userId := e.UserId.String()
+		res.UserId = &userId
+	}
+	if e.ReplacedBy != nil {
+		replacedBy := e.ReplacedBy.String()
+		res.ReplacedBy = &replacedBy
+	}
+	if e.ActorId != nil {
+		actorId := e.ActorId.String()
+		res.ActorId = &actorId
+	}
+	return res
+}
+
+func MapMembershipEventToAPI(e domain.MembershipEvent) apigen.MembershipEvent {
+	res := apigen.MembershipEvent{
+		Id:        e.Id,
+		UserId:    e.UserId.String(),
+		Reason:    e.Reason,
+		CreatedAt: e.CreatedAt,
+	}
+	if e.FromTeamId != nil {
+		fromTeamId := e.FromTeamId.String()
+		res.FromTeamId = &fromTeamId
+	}
+	if e.ToTeamId != nil {
+		toTeamId := e.ToTeamId.String()
+		res.ToTeamId = &toTeamId
+	}
+	if e.ActorId != nil {
+		actorId := e.ActorId.String()
+		res.ActorId = &actorId
+	}
+	return res
+}
+
+func MapReviewReassignmentToAPI(r domain.ReviewReassignment) apigen.ReviewReassignment {
+	return apigen.ReviewReassignment{
+		PullRequestId: r.PullRequestId.String(),
+		ReplacedBy:    r.ReplacedBy.String(),
+	}
 }
 
 func MapPullRequestWithReviewersToAPI(pr domain.PullRequestWithReviewers) apigen.PullRequest {
@@ -87,6 +261,24 @@ func MapPullRequestWithReviewersToAPI(pr domain.PullRequestWithReviewers) apigen
 		reviewers[i] = id.String()
 	}
 
+	var fallbackReviewers *[]string
+	if len(pr.FallbackReviewers) > 0 {
+		ids := make([]string, len(pr.FallbackReviewers))
+		for i, id := range pr.FallbackReviewers {
+			ids[i] = id.String()
+		}
+		fallbackReviewers = &ids
+	}
+
+	var reviews *[]apigen.Review
+	if pr.Reviews != nil {
+		out := make([]apigen.Review, len(pr.Reviews))
+		for i, r := range pr.Reviews {
+			out[i] = MapReviewToAPI(r)
+		}
+		reviews = &out
+	}
+
 	return apigen.PullRequest{
 		PullRequestId:     pr.PullRequestId.String(),
 		PullRequestName:   pr.PullRequestName,
@@ -95,5 +287,158 @@ func MapPullRequestWithReviewersToAPI(pr domain.PullRequestWithReviewers) apigen
 		CreatedAt:         pr.CreatedAt,
 		MergedAt:          pr.MergedAt,
 		AssignedReviewers: reviewers,
+		FallbackReviewers: fallbackReviewers,
+		Reviews:           reviews,
+	}
+}
+
+func MapAPIListParamsToDomainFilter(p apigen.GetPullRequestListParams) (domain.PullRequestFilter, error) {
+	filter := domain.PullRequestFilter{
+		Order:         domain.SortOrderDesc,
+		CreatedAfter:  p.CreatedAfter,
+		CreatedBefore: p.CreatedBefore,
+	}
+
+	if p.Status != nil {
+		status := domain.PullRequestStatus(*p.Status)
+		filter.Status = &status
+	}
+	if p.AuthorId != nil {
+		authorId, err := ParseUUID(*p.AuthorId)
+		if err != nil {
+			return domain.PullRequestFilter{}, err
+		}
+		filter.AuthorId = &authorId
+	}
+	if p.ReviewerId != nil {
+		reviewerId, err := ParseUUID(*p.ReviewerId)
+		if err != nil {
+			return domain.PullRequestFilter{}, err
+		}
+		filter.ReviewerId = &reviewerId
+	}
+	if p.Order != nil && *p.Order == apigen.Asc {
+		filter.Order = domain.SortOrderAsc
+	}
+	if p.Limit != nil {
+		if *p.Limit < 1 || *p.Limit > domain.MaxPageLimit {
+			return domain.PullRequestFilter{}, apperrors.ErrInvalidLimit
+		}
+		filter.Limit = *p.Limit
+	}
+	if p.Cursor != nil {
+		cursor, err := DecodeCursor(*p.Cursor)
+		if err != nil {
+			return domain.PullRequestFilter{}, err
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
+
+func MapAPITokenToAPI(t domain.APIToken) apigen.APIToken {
+	out := apigen.APIToken{
+		TokenId:   t.TokenId.String(),
+		Role:      apigen.Role(t.Role),
+		CreatedAt: t.CreatedAt,
+		RevokedAt: t.RevokedAt,
+	}
+	if t.UserId != nil {
+		userId := t.UserId.String()
+		out.UserId = &userId
+	}
+	return out
+}
+
+func MapWebhookToAPI(w domain.Webhook) apigen.Webhook {
+	eventTypes := make([]apigen.EventType, len(w.EventTypes))
+	for i, t := range w.EventTypes {
+		eventTypes[i] = apigen.EventType(t)
+	}
+	return apigen.Webhook{
+		WebhookId:  w.WebhookId.String(),
+		Url:        w.URL,
+		EventTypes: eventTypes,
+		IsActive:   w.IsActive,
+		CreatedAt:  w.CreatedAt,
+	}
+}
+
+// MapAPIEventTypes nil stays nil, so an omitted list can be told from an empty one
+func MapAPIEventTypes(eventTypes *[]apigen.EventType) *[]domain.EventType {
+	if eventTypes == nil {
+		return nil
+	}
+	out := make([]domain.EventType, len(*eventTypes))
+	for i, t := range *eventTypes {
+		out[i] = domain.EventType(t)
+	}
+	return &out
+}
+
+func MapEventToAPI(e domain.Event) apigen.Event {
+	data := apigen.EventData{
+		PullRequestId:   uuidToAPI(e.Data.PullRequestId),
+		AuthorId:        uuidToAPI(e.Data.AuthorId),
+		UserId:          uuidToAPI(e.Data.UserId),
+		ActorId:         uuidToAPI(e.Data.ActorId),
+		PullRequestName: nonEmpty(e.Data.PullRequestName),
+		Reason:          nonEmpty(e.Data.Reason),
+	}
+	if e.Data.Status != nil {
+		status := apigen.PullRequestStatus(*e.Data.Status)
+		data.Status = &status
+	}
+	if e.Data.PreviousStatus != nil {
+		status := apigen.PullRequestStatus(*e.Data.PreviousStatus)
+		data.PreviousStatus = &status
+	}
+	if e.Data.ReviewState != nil {
+		state := apigen.ReviewState(*e.Data.ReviewState)
+		data.ReviewState = &state
+	}
+
+	return apigen.Event{
+		Id:         e.Id.String(),
+		Type:       apigen.EventType(e.Type),
+		Version:    e.Version,
+		OccurredAt: e.OccurredAt,
+		Data:       data,
+	}
+}
+
+func MapEventPageToAPI(page domain.EventPage) apigen.EventPage {
+	events := make([]apigen.EventRecord, len(page.Events))
+	for i, rec := range page.Events {
+		events[i] = apigen.EventRecord{
+			Cursor: EncodeEventCursor(rec.Cursor),
+			Event:  MapEventToAPI(rec.Event),
+		}
+	}
+
+	out := apigen.EventPage{
+		Events:  events,
+		HasMore: page.HasMore,
+	}
+	if page.NextCursor != nil {
+		cursor := EncodeEventCursor(*page.NextCursor)
+		out.NextCursor = &cursor
+	}
+	return out
+}
+
+func uuidToAPI(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
 	}
+	return &s
 }
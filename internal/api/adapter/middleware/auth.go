@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"errors"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const bearerPrefix = "Bearer "
+
+// schemeRoles maps OpenAPI security schemes to the roles they admit
+var schemeRoles = map[string]domain.Role{
+	apigen.AdminAuthScopes:    domain.RoleAdmin,
+	apigen.TeamLeadAuthScopes: domain.RoleTeamLead,
+	apigen.UserAuthScopes:     domain.RoleUser,
+}
+
+// Authenticate resolves the bearer token into a principal stored in the
+// request context; requests without a token pass through anonymously and
+// are rejected later by Authorize if the operation is protected
+func Authenticate(auth service.Auth) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			header := c.Request().Header.Get(echo.HeaderAuthorization)
+			if header == "" {
+				return next(c)
+			}
+			if !strings.HasPrefix(header, bearerPrefix) {
+				return apperrors.ErrUnauthorized
+			}
+
+			ctx := c.Request().Context()
+			principal, err := auth.Authenticate(ctx, strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix)))
+			if err != nil {
+				if errors.Is(err, service.ErrUnauthorized) {
+					return apperrors.ErrUnauthorized
+				}
+				return err
+			}
+
+			ctx = domain.WithPrincipal(ctx, principal)
+			if principal.UserId != nil {
+				ctx = domain.WithActor(ctx, *principal.UserId)
+			}
+			c.SetRequest(c.Request().WithContext(ctx))
+			return next(c)
+		}
+	}
+}
+
+// Authorize checks the principal role against the security schemes the
+// generated wrapper put into the echo context for the operation
+func Authorize() apigen.StrictMiddlewareFunc {
+	return func(f apigen.StrictHandlerFunc, operationID string) apigen.StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			allowed := make(map[domain.Role]bool, len(schemeRoles))
+			for scheme, role := range schemeRoles {
+				if c.Get(scheme) != nil {
+					allowed[role] = true
+				}
+			}
+			if len(allowed) == 0 {
+				return f(c, request)
+			}
+
+			principal, ok := domain.PrincipalFromContext(c.Request().Context())
+			if !ok {
+				return nil, apperrors.ErrUnauthorized
+			}
+			if !allowed[principal.Role] {
+				return nil, apperrors.ErrForbidden
+			}
+			return f(c, request)
+		}
+	}
+}
@@ -2,9 +2,14 @@ package middleware
 
 import (
 	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
+	gv "github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/sirupsen/logrus"
 )
@@ -16,41 +21,112 @@ func NewHTTPErrorHandler(log *logrus.Logger) echo.HTTPErrorHandler {
 			return
 		}
 
+		status, code, message := classifyError(err)
+
 		// Log error with useful request context
 		entry := log.WithFields(logrus.Fields{
-			"path":   c.Path(),
-			"method": c.Request().Method,
+			"path":       c.Path(),
+			"method":     c.Request().Method,
+			"status":     status,
+			"request_id": RequestIDFromContext(c.Request().Context()),
 		}).WithError(err)
 
-		entry.Error("request failed")
-
-		// map known application errors -> HTTP responses
-		if errors.Is(err, apperrors.ErrInvalidUUID) {
-			if !c.Response().Committed {
-				_ = c.JSON(http.StatusBadRequest, map[string]any{
-					"error": "invalid uuid",
-				})
-			}
-			return
+		if status >= http.StatusInternalServerError {
+			entry.Error("request failed")
+		} else {
+			entry.Warn("request rejected")
 		}
 
-		// if it's an echo HTTPError, preserve code/message
-		if httpErr, ok := err.(*echo.HTTPError); ok {
-			code := httpErr.Code
-			message := httpErr.Message
-			if !c.Response().Committed {
-				_ = c.JSON(code, map[string]any{
-					"error": message,
-				})
-			}
-			return
+		if !c.Response().Committed {
+			_ = c.JSON(status, NewErrorResponse(c.Request().Context(), code, message))
 		}
+	}
+}
+
+// NewErrorResponse builds the ErrorResponse body stamped with the request id
+func NewErrorResponse(ctx context.Context, code apigen.ErrorResponseErrorCode, message string) apigen.ErrorResponse {
+	var resp apigen.ErrorResponse
+	resp.Error.Code = code
+	resp.Error.Message = message
+	if id := RequestIDFromContext(ctx); id != "" {
+		resp.Error.RequestId = &id
+	}
+	return resp
+}
+
+// classifyError maps errors escaping the handlers to status, code and a
+// message safe to show to clients
+func classifyError(err error) (int, apigen.ErrorResponseErrorCode, string) {
+	var validationErrs gv.ValidationErrors
+	var httpErr *echo.HTTPError
 
+	switch {
+	case errors.Is(err, apperrors.ErrInvalidUUID),
+		errors.Is(err, apperrors.ErrInvalidCursor),
+		errors.Is(err, apperrors.ErrInvalidLimit),
+		errors.Is(err, apperrors.ErrInvalidIdempotencyKey):
+		return http.StatusBadRequest, apigen.VALIDATIONFAILED, err.Error()
+	case errors.As(err, &validationErrs):
+		return http.StatusBadRequest, apigen.VALIDATIONFAILED, formatValidationErrors(validationErrs)
+	case errors.Is(err, apperrors.ErrEmptyBody):
+		return http.StatusBadRequest, apigen.BADREQUEST, err.Error()
+	case errors.Is(err, apperrors.ErrUnauthorized):
+		return http.StatusUnauthorized, apigen.UNAUTHORIZED, err.Error()
+	case errors.Is(err, apperrors.ErrForbidden):
+		return http.StatusForbidden, apigen.FORBIDDEN, err.Error()
+	case errors.Is(err, apperrors.ErrIdempotencyKeyInProgress):
+		return http.StatusConflict, apigen.IDEMPOTENCYKEYINPROGRESS, err.Error()
+	case errors.Is(err, apperrors.ErrIdempotencyKeyReused):
+		return http.StatusUnprocessableEntity, apigen.IDEMPOTENCYKEYREUSED, err.Error()
+	case errors.Is(err, apperrors.ErrRequestTooLarge):
+		return http.StatusRequestEntityTooLarge, apigen.PAYLOADTOOLARGE, err.Error()
+	case errors.As(err, &httpErr):
+		// if it's an echo HTTPError, preserve code/message
+		return httpErr.Code, httpErrorCode(httpErr.Code), httpErrorMessage(httpErr)
+	default:
 		// fallback: unexpected internal error -> 500
-		if !c.Response().Committed {
-			_ = c.JSON(http.StatusInternalServerError, map[string]any{
-				"error": "internal server error",
-			})
+		return http.StatusInternalServerError, apigen.INTERNAL, "internal server error"
+	}
+}
+
+func httpErrorCode(status int) apigen.ErrorResponseErrorCode {
+	switch {
+	case status == http.StatusUnauthorized:
+		return apigen.UNAUTHORIZED
+	case status == http.StatusForbidden:
+		return apigen.FORBIDDEN
+	case status == http.StatusNotFound:
+		return apigen.NOTFOUND
+	case status == http.StatusMethodNotAllowed:
+		return apigen.METHODNOTALLOWED
+	case status == http.StatusUnsupportedMediaType:
+		return apigen.UNSUPPORTEDMEDIATYPE
+	case status == http.StatusRequestEntityTooLarge:
+		return apigen.PAYLOADTOOLARGE
+	case status >= http.StatusInternalServerError:
+		return apigen.INTERNAL
+	default:
+		return apigen.BADREQUEST
+	}
+}
+
+func httpErrorMessage(httpErr *echo.HTTPError) string {
+	if httpErr.Code >= http.StatusInternalServerError {
+		return "internal server error"
+	}
+	return fmt.Sprint(httpErr.Message)
+}
+
+func formatValidationErrors(errs gv.ValidationErrors) string {
+	parts := make([]string, len(errs))
+	for i, fe := range errs {
+		// drop the request object and Body/Params prefix, keep json names
+		field := fe.Namespace()
+		if _, rest, ok := strings.Cut(field, "."); ok {
+			field = rest
 		}
+		field = strings.TrimPrefix(strings.TrimPrefix(field, "Body."), "Params.")
+		parts[i] = fmt.Sprintf("%s failed on %s", field, fe.Tag())
 	}
+	return "validation failed: " + strings.Join(parts, "; ")
 }
@@ -0,0 +1,125 @@
+package middleware
+
+import (
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	HeaderIdempotencyKey      = "Idempotency-Key"
+	HeaderIdempotencyReplayed = "Idempotency-Replayed"
+
+	maxIdempotencyKeyLength = 255
+	// maxIdempotentBodySize bounds the body read into memory for hashing
+	maxIdempotentBodySize = 1 << 20
+)
+
+// Idempotency replays the stored response of a POST repeated with the same
+// Idempotency-Key instead of running the operation again. Keys are scoped by
+// the caller; 5xx responses are not stored so the request can be retried
+func Idempotency(idempotency service.Idempotency) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+			key := req.Header.Get(HeaderIdempotencyKey)
+			if req.Method != http.MethodPost || key == "" {
+				return next(c)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return apperrors.ErrInvalidIdempotencyKey
+			}
+
+			// anonymous requests are rejected by Authorize anyway
+			principal, ok := domain.PrincipalFromContext(req.Context())
+			if !ok {
+				return next(c)
+			}
+			scope := idempotencyScope(principal)
+
+			body, err := io.ReadAll(http.MaxBytesReader(c.Response(), req.Body, maxIdempotentBodySize))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					return apperrors.ErrRequestTooLarge
+				}
+				return fmt.Errorf("read request body: %w", err)
+			}
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			hash := sha256.New()
+			hash.Write([]byte(req.Method + " " + req.URL.RequestURI() + "\n"))
+			hash.Write(body)
+
+			stored, err := idempotency.Begin(req.Context(), scope, key, hash.Sum(nil))
+			if err != nil {
+				switch {
+				case errors.Is(err, service.ErrIdempotencyKeyInProgress):
+					return apperrors.ErrIdempotencyKeyInProgress
+				case errors.Is(err, service.ErrIdempotencyKeyReused):
+					return apperrors.ErrIdempotencyKeyReused
+				default:
+					return err
+				}
+			}
+			if stored != nil {
+				c.Response().Header().Set(HeaderIdempotencyReplayed, "true")
+				return c.Blob(*stored.StatusCode, echo.MIMEApplicationJSON, stored.Body)
+			}
+
+			recorder := &bodyRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			// errors are rendered here so their body is recorded as well
+			if err := next(c); err != nil {
+				c.Error(err)
+			}
+
+			// the response is stored even if the client has gone
+			ctx := context.WithoutCancel(req.Context())
+			status := c.Response().Status
+			if status >= http.StatusInternalServerError {
+				if err := idempotency.Release(ctx, scope, key); err != nil {
+					return fmt.Errorf("release idempotency key: %w", err)
+				}
+				return nil
+			}
+			if err := idempotency.Complete(ctx, scope, key, status, recorder.body.Bytes()); err != nil {
+				return fmt.Errorf("store idempotent response: %w", err)
+			}
+			return nil
+		}
+	}
+}
+
+// idempotencyScope keeps keys of different tokens and SSO users apart
+func idempotencyScope(p domain.Principal) string {
+	if p.Subject != "" {
+		return "jwt:" + p.Subject
+	}
+	return "token:" + p.TokenId.String()
+}
+
+// bodyRecorder copies the response body while writing it to the client
+type bodyRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
+
+func (r *bodyRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
+}
@@ -0,0 +1,26 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/labstack/echo/v4"
+	echomw "github.com/labstack/echo/v4/middleware"
+)
+
+type requestIDKey struct{}
+
+// RequestID takes X-Request-ID from the request or generates one, echoes
+// it in the response and keeps it in the request context for error bodies
+func RequestID() echo.MiddlewareFunc {
+	return echomw.RequestIDWithConfig(echomw.RequestIDConfig{
+		RequestIDHandler: func(c echo.Context, id string) {
+			ctx := context.WithValue(c.Request().Context(), requestIDKey{}, id)
+			c.SetRequest(c.Request().WithContext(ctx))
+		},
+	})
+}
+
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
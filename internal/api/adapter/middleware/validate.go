@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	apigen "avito-test-applicant/internal/api/gen"
+	"reflect"
+	"strings"
+
+	gv "github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+// RequestValidator adapts go-playground/validator to echo.Validator
+type RequestValidator struct{ v *gv.Validate }
+
+// NewRequestValidator reports fields by their json names
+func NewRequestValidator() *RequestValidator {
+	v := gv.New()
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return &RequestValidator{v: v}
+}
+
+func (cv *RequestValidator) Validate(i interface{}) error {
+	return cv.v.Struct(i)
+}
+
+// Validate runs the echo validator over the decoded request object, so
+// the validate tags generated from the spec are enforced. An empty body
+// decodes into a zero object and is rejected as such, not field by field
+func Validate() apigen.StrictMiddlewareFunc {
+	return func(f apigen.StrictHandlerFunc, operationID string) apigen.StrictHandlerFunc {
+		return func(c echo.Context, request interface{}) (interface{}, error) {
+			if c.Request().ContentLength == 0 && hasBody(request) {
+				return nil, apperrors.ErrEmptyBody
+			}
+			if err := c.Validate(request); err != nil {
+				return nil, err
+			}
+			return f(c, request)
+		}
+	}
+}
+
+// hasBody reports whether the operation takes a JSON body; every such body
+// is required by the spec
+func hasBody(request interface{}) bool {
+	v := reflect.ValueOf(request)
+	if v.Kind() != reflect.Struct {
+		return false
+	}
+	body := v.FieldByName("Body")
+	return body.IsValid() && body.Kind() == reflect.Pointer
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -15,76 +16,488 @@ import (
 	strictecho "github.com/oapi-codegen/runtime/strictmiddleware/echo"
 )
 
+const (
+	AdminAuthScopes    = "AdminAuth.Scopes"
+	TeamLeadAuthScopes = "TeamLeadAuth.Scopes"
+	UserAuthScopes     = "UserAuth.Scopes"
+)
+
+// Defines values for AllUsers.
+const (
+	All AllUsers = "all"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYASSIGNED          ErrorResponseErrorCode = "ALREADY_ASSIGNED"
+	BADREQUEST               ErrorResponseErrorCode = "BAD_REQUEST"
+	FORBIDDEN                ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYKEYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_KEY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED     ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INTERNAL                 ErrorResponseErrorCode = "INTERNAL"
+	INVALIDFALLBACKTEAM      ErrorResponseErrorCode = "INVALID_FALLBACK_TEAM"
+	INVALIDMERGEPOLICY       ErrorResponseErrorCode = "INVALID_MERGE_POLICY"
+	INVALIDREVIEWER          ErrorResponseErrorCode = "INVALID_REVIEWER"
+	INVALIDREVIEWERSREQUIRED ErrorResponseErrorCode = "INVALID_REVIEWERS_REQUIRED"
+	INVALIDREVIEWSTATE       ErrorResponseErrorCode = "INVALID_REVIEW_STATE"
+	INVALIDSTATUSTRANSITION  ErrorResponseErrorCode = "INVALID_STATUS_TRANSITION"
+	INVALIDSTRATEGY          ErrorResponseErrorCode = "INVALID_STRATEGY"
+	INVALIDTOKENREQUEST      ErrorResponseErrorCode = "INVALID_TOKEN_REQUEST"
+	INVALIDWEBHOOK           ErrorResponseErrorCode = "INVALID_WEBHOOK"
+	METHODNOTALLOWED         ErrorResponseErrorCode = "METHOD_NOT_ALLOWED"
+	NOCANDIDATE              ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTAPPROVED              ErrorResponseErrorCode = "NOT_APPROVED"
+	NOTASSIGNED              ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND                 ErrorResponseErrorCode = "NOT_FOUND"
+	PAYLOADTOOLARGE          ErrorResponseErrorCode = "PAYLOAD_TOO_LARGE"
+	PREXISTS                 ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED                 ErrorResponseErrorCode = "PR_MERGED"
+	PRNOTOPEN                ErrorResponseErrorCode = "PR_NOT_OPEN"
+	TEAMEXISTS               ErrorResponseErrorCode = "TEAM_EXISTS"
+	TOOMANYREVIEWERS         ErrorResponseErrorCode = "TOO_MANY_REVIEWERS"
+	UNAUTHORIZED             ErrorResponseErrorCode = "UNAUTHORIZED"
+	UNSUPPORTEDMEDIATYPE     ErrorResponseErrorCode = "UNSUPPORTED_MEDIA_TYPE"
+	USEREXISTS               ErrorResponseErrorCode = "USER_EXISTS"
+	USERINOTHERTEAM          ErrorResponseErrorCode = "USER_IN_OTHER_TEAM"
+	USERNAMETAKEN            ErrorResponseErrorCode = "USERNAME_TAKEN"
+	VALIDATIONFAILED         ErrorResponseErrorCode = "VALIDATION_FAILED"
 )
 
-// Defines values for PullRequestStatus.
+// Defines values for EventType.
 const (
-	PullRequestStatusMERGED PullRequestStatus = "MERGED"
-	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+	PullRequestCreated       EventType = "pull_request.created"
+	PullRequestMerged        EventType = "pull_request.merged"
+	PullRequestStatusChanged EventType = "pull_request.status_changed"
+	ReviewSubmitted          EventType = "review.submitted"
+	ReviewerAssigned         EventType = "reviewer.assigned"
+	ReviewerUnassigned       EventType = "reviewer.unassigned"
+	UserActivated            EventType = "user.activated"
+	UserDeactivated          EventType = "user.deactivated"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
-// ErrorResponse defines model for ErrorResponse.
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for ReviewState.
+const (
+	ReviewStateAPPROVED         ReviewState = "APPROVED"
+	ReviewStateCHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	ReviewStatePENDING          ReviewState = "PENDING"
+)
+
+// Defines values for ReviewerEventType.
+const (
+	ReviewerEventTypeASSIGNED    ReviewerEventType = "ASSIGNED"
+	ReviewerEventTypeDEACTIVATED ReviewerEventType = "DEACTIVATED"
+	ReviewerEventTypeMERGED      ReviewerEventType = "MERGED"
+	ReviewerEventTypeREASSIGNED  ReviewerEventType = "REASSIGNED"
+	ReviewerEventTypeUNASSIGNED  ReviewerEventType = "UNASSIGNED"
+)
+
+// Defines values for Role.
+const (
+	RoleAdmin    Role = "admin"
+	RoleTeamLead Role = "team-lead"
+	RoleUser     Role = "user"
+)
+
+// Defines values for SelectionStrategy.
+const (
+	LeastLoaded SelectionStrategy = "least_loaded"
+	Random      SelectionStrategy = "random"
+	RoundRobin  SelectionStrategy = "round_robin"
+	Weighted    SelectionStrategy = "weighted"
+)
+
+// Defines values for GetPullRequestListParamsStatus.
+const (
+	GetPullRequestListParamsStatusCLOSED GetPullRequestListParamsStatus = "CLOSED"
+	GetPullRequestListParamsStatusDRAFT  GetPullRequestListParamsStatus = "DRAFT"
+	GetPullRequestListParamsStatusMERGED GetPullRequestListParamsStatus = "MERGED"
+	GetPullRequestListParamsStatusOPEN   GetPullRequestListParamsStatus = "OPEN"
+)
+
+// Defines values for GetPullRequestListParamsOrder.
+const (
+	Asc  GetPullRequestListParamsOrder = "asc"
+	Desc GetPullRequestListParamsOrder = "desc"
+)
+
+// Defines values for PostPullRequestReviewJSONBodyState.
+const (
+	PostPullRequestReviewJSONBodyStateAPPROVED         PostPullRequestReviewJSONBodyState = "APPROVED"
+	PostPullRequestReviewJSONBodyStateCHANGESREQUESTED PostPullRequestReviewJSONBodyState = "CHANGES_REQUESTED"
+)
+
+// APIToken defines model for APIToken.
+type APIToken struct {
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+	Role      Role       `json:"role"`
+	TokenId   string     `json:"token_id"`
+	UserId    *string    `json:"user_id,omitempty"`
+}
+
+// AllUsers Все участники команды
+type AllUsers string
+
+// AuthorStats defines model for AuthorStats.
+type AuthorStats struct {
+	PullRequests int    `json:"pull_requests"`
+	UserId       string `json:"user_id"`
+	Username     string `json:"username"`
+}
+
+// ErrorResponse Единый формат ошибок. Кроме доменных кодов операций:
+// BAD_REQUEST — тело пустое или тело и параметры не разбираются, VALIDATION_FAILED — некорректные поля
+// (невалидный UUID, курсор, лимит, пустые обязательные строки), UNAUTHORIZED / FORBIDDEN — авторизация,
+// NOT_FOUND — неизвестный путь,
+// METHOD_NOT_ALLOWED (405) / UNSUPPORTED_MEDIA_TYPE (415) — метод или Content-Type не поддерживаются, INTERNAL — внутренняя ошибка,
+// IDEMPOTENCY_KEY_IN_PROGRESS (409) / IDEMPOTENCY_KEY_REUSED (422) — повтор запроса с Idempotency-Key,
+// PAYLOAD_TOO_LARGE (413) — слишком большое тело запроса.
 type ErrorResponse struct {
 	Error struct {
 		Code    ErrorResponseErrorCode `json:"code"`
 		Message string                 `json:"message"`
+
+		// RequestId Идентификатор запроса, совпадает с заголовком X-Request-ID
+		RequestId *string `json:"request_id,omitempty"`
+
+		// Users Пользователи, из-за которых возник конфликт (USER_IN_OTHER_TEAM)
+		Users *[]User `json:"users,omitempty"`
 	} `json:"error"`
 }
 
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// Event Версионированный JSON-документ события; тот же документ отправляется в webhook-и.
+// В пределах версии поля только добавляются, повторы отбрасываются по id
+type Event struct {
+	// Data Набор полей зависит от типа события
+	Data       EventData `json:"data"`
+	Id         string    `json:"id"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Type       EventType `json:"type"`
+
+	// Version Версия схемы документа, сейчас 1
+	Version int `json:"version"`
+}
+
+// EventData Набор полей зависит от типа события
+type EventData struct {
+	// ActorId Пользователь, совершивший изменение; отсутствует, если неизвестен
+	ActorId         *string            `json:"actor_id,omitempty"`
+	AuthorId        *string            `json:"author_id,omitempty"`
+	PreviousStatus  *PullRequestStatus `json:"previous_status,omitempty"`
+	PullRequestId   *string            `json:"pull_request_id,omitempty"`
+	PullRequestName *string            `json:"pull_request_name,omitempty"`
+	Reason          *string            `json:"reason,omitempty"`
+	ReviewState     *ReviewState       `json:"review_state,omitempty"`
+	Status          *PullRequestStatus `json:"status,omitempty"`
+	UserId          *string            `json:"user_id,omitempty"`
+}
+
+// EventPage defines model for EventPage.
+type EventPage struct {
+	Events []EventRecord `json:"events"`
+
+	// HasMore Есть ещё события, их можно запросить сразу
+	HasMore bool `json:"has_more"`
+
+	// NextCursor Откуда продолжать чтение: курсор последнего события или переданный after, если новых нет.
+	// Отсутствует, пока лента пуста
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// EventRecord defines model for EventRecord.
+type EventRecord struct {
+	// Cursor Позиция события в ленте, передаётся в after для продолжения
+	Cursor string `json:"cursor"`
+
+	// Event Версионированный JSON-документ события; тот же документ отправляется в webhook-и.
+	// В пределах версии поля только добавляются, повторы отбрасываются по id
+	Event Event `json:"event"`
+}
+
+// EventType defines model for EventType.
+type EventType string
+
+// MembershipChange defines model for MembershipChange.
+type MembershipChange struct {
+	// Reviews Открытые ревью пользователя — переданные другим ревьюверам или снятые, если кандидата нет (без replaced_by)
+	Reviews []ReviewHandover `json:"reviews"`
+	User    User             `json:"user"`
+}
+
+// MembershipEvent defines model for MembershipEvent.
+type MembershipEvent struct {
+	// ActorId Кто выполнил изменение; отсутствует, если неизвестно
+	ActorId   *string   `json:"actor_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// FromTeamId Команда, которую пользователь покинул; отсутствует, если команды не было
+	FromTeamId *string `json:"from_team_id,omitempty"`
+	Id         int64   `json:"id"`
+	Reason     string  `json:"reason"`
+
+	// ToTeamId Команда, в которую пользователь перешёл; отсутствует при исключении из команды
+	ToTeamId *string `json:"to_team_id,omitempty"`
+	UserId   string  `json:"user_id"`
+}
+
+// MergePolicy defines model for MergePolicy.
+type MergePolicy struct {
+	// LeadUserId Лид команды; обязателен при require_lead_approval
+	LeadUserId *string `json:"lead_user_id,omitempty"`
+
+	// RequireLeadApproval Нужно ли одобрение лида команды. Лид может оставить вердикт,
+	// даже если не был выбран ревьювером: он назначается при отправке
+	// вердикта
+	RequireLeadApproval bool `json:"require_lead_approval"`
+
+	// RequiredApprovals Сколько ревьюверов должны одобрить PR перед мержем
+	RequiredApprovals int    `json:"required_approvals"`
+	TeamName          string `json:"team_name" validate:"required"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (0..reviewers_required команды автора)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// FallbackReviewers user_id ревьюверов, взятых из резервных команд; определяется на момент назначения и не меняется при переводах
+	FallbackReviewers *[]string  `json:"fallback_reviewers,omitempty"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// Reviews Состояние ревью каждого назначенного ревьювера
+	Reviews *[]Review         `json:"reviews,omitempty"`
+	Status  PullRequestStatus `json:"status"`
 }
 
-// PullRequestStatus defines model for PullRequest.Status.
-type PullRequestStatus string
+// PullRequestHistory defines model for PullRequestHistory.
+type PullRequestHistory struct {
+	Events        []ReviewerEvent `json:"events"`
+	PullRequestId string          `json:"pull_request_id"`
+}
+
+// PullRequestIdRequest defines model for PullRequestIdRequest.
+type PullRequestIdRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PullRequestResponse defines model for PullRequestResponse.
+type PullRequestResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
 	PullRequestId   string                 `json:"pull_request_id"`
 	PullRequestName string                 `json:"pull_request_name"`
+	ReviewState     *ReviewState           `json:"review_state,omitempty"`
 	Status          PullRequestShortStatus `json:"status"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// PullRequestStatus defines model for PullRequestStatus.
+type PullRequestStatus string
+
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// ReviewedAt Время последнего вердикта
+	ReviewedAt *time.Time  `json:"reviewed_at"`
+	State      ReviewState `json:"state"`
+	UserId     string      `json:"user_id"`
+}
+
+// ReviewHandover defines model for ReviewHandover.
+type ReviewHandover struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// ReplacedBy Новый ревьювер; отсутствует, если кандидата не нашлось и ревью снято
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+
+	// UserId Деактивированный или покинувший команду ревьювер
+	UserId string `json:"user_id"`
+}
+
+// ReviewReassignment defines model for ReviewReassignment.
+type ReviewReassignment struct {
+	PullRequestId string `json:"pull_request_id"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+// ReviewState defines model for ReviewState.
+type ReviewState string
+
+// ReviewerChangeRequest defines model for ReviewerChangeRequest.
+type ReviewerChangeRequest struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// ReviewerEvent defines model for ReviewerEvent.
+type ReviewerEvent struct {
+	// ActorId Кто выполнил изменение; отсутствует, если неизвестно
+	ActorId   *string   `json:"actor_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Reason    string    `json:"reason"`
+
+	// ReplacedBy Новый ревьювер для REASSIGNED и DEACTIVATED
+	ReplacedBy *string `json:"replaced_by,omitempty"`
+
+	// Type DEACTIVATED — ревью передано из-за деактивации ревьювера
+	Type ReviewerEventType `json:"type"`
+
+	// UserId Ревьювер, которого касается событие; отсутствует для MERGED
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// ReviewerEventType DEACTIVATED — ревью передано из-за деактивации ревьювера
+type ReviewerEventType string
+
+// ReviewerStats defines model for ReviewerStats.
+type ReviewerStats struct {
+	MergedReviews    int    `json:"merged_reviews"`
+	OpenReviews      int    `json:"open_reviews"`
+	TotalAssignments int    `json:"total_assignments"`
+	UserId           string `json:"user_id"`
+	Username         string `json:"username"`
+}
+
+// Role defines model for Role.
+type Role string
+
+// SelectionStrategy Стратегия выбора ревьюверов
+type SelectionStrategy string
+
+// Stats defines model for Stats.
+type Stats struct {
+	Authors                      []AuthorStats   `json:"authors"`
+	AvgReviewersPerPullRequest   float64         `json:"avg_reviewers_per_pull_request"`
+	PullRequestsWithoutReviewers int             `json:"pull_requests_without_reviewers"`
+	Reviewers                    []ReviewerStats `json:"reviewers"`
+
+	// TeamName Заполнено для статистики по одной команде
+	TeamName          *string `json:"team_name,omitempty"`
+	TotalPullRequests int     `json:"total_pull_requests"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members" validate:"dive"`
+
+	// ReviewersRequired Сколько ревьюверов назначать на каждый PR команды
+	ReviewersRequired *int   `json:"reviewers_required,omitempty"`
+	TeamName          string `json:"team_name" validate:"required"`
+}
+
+// TeamAddRequest defines model for TeamAddRequest.
+type TeamAddRequest struct {
+	Members []TeamMember `json:"members" validate:"dive"`
+
+	// MoveExisting Переводить участников других команд. Без флага такие пользователи
+	// дают 409 USER_IN_OTHER_TEAM со списком в error.users
+	MoveExisting *bool `json:"move_existing,omitempty"`
+
+	// ReviewersRequired Сколько ревьюверов назначать на каждый PR команды
+	ReviewersRequired *int   `json:"reviewers_required,omitempty"`
+	TeamName          string `json:"team_name" validate:"required"`
+}
+
+// TeamDeactivateUsersRequest defines model for TeamDeactivateUsersRequest.
+type TeamDeactivateUsersRequest struct {
+	TeamName string                             `json:"team_name" validate:"required"`
+	UserIds  TeamDeactivateUsersRequest_UserIds `json:"user_ids"`
+}
+
+// TeamDeactivateUsersRequest_UserIds defines model for TeamDeactivateUsersRequest.UserIds.
+type TeamDeactivateUsersRequest_UserIds struct {
+	union json.RawMessage
+}
+
+// TeamDeactivation defines model for TeamDeactivation.
+type TeamDeactivation struct {
+	Deactivated []string         `json:"deactivated"`
+	Reviews     []ReviewHandover `json:"reviews"`
+	TeamName    string           `json:"team_name"`
+}
+
+// TeamFallbacks defines model for TeamFallbacks.
+type TeamFallbacks struct {
+	// FallbackTeams Резервные команды в порядке приоритета
+	FallbackTeams []string `json:"fallback_teams"`
+	TeamName      string   `json:"team_name" validate:"required"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
 	IsActive bool   `json:"is_active"`
 	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	Username string `json:"username" validate:"required"`
+}
+
+// TeamMembersRequest defines model for TeamMembersRequest.
+type TeamMembersRequest struct {
+	Members  []TeamMember `json:"members" validate:"dive"`
+	TeamName string       `json:"team_name" validate:"required"`
+}
+
+// TeamRemoveMemberRequest defines model for TeamRemoveMemberRequest.
+type TeamRemoveMemberRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	UserId   string `json:"user_id"`
+}
+
+// TeamRenameRequest defines model for TeamRenameRequest.
+type TeamRenameRequest struct {
+	NewTeamName string `json:"new_team_name" validate:"required"`
+	TeamName    string `json:"team_name" validate:"required"`
+}
+
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// ReviewersRequired Сколько ревьюверов назначать на каждый PR команды; без него в запросе не меняется.
+	// Действует на новые PR, у открытых PR число ревьюверов остаётся прежним
+	ReviewersRequired *int `json:"reviewers_required,omitempty"`
+
+	// SelectionStrategy Стратегия выбора ревьюверов
+	SelectionStrategy SelectionStrategy `json:"selection_strategy"`
+	TeamName          string            `json:"team_name" validate:"required"`
+}
+
+// TokenRequest defines model for TokenRequest.
+type TokenRequest struct {
+	Role Role `json:"role"`
+
+	// UserId Владелец токена, обязателен для team-lead и user
+	UserId *string `json:"user_id,omitempty"`
 }
 
 // User defines model for User.
@@ -95,28 +508,209 @@ type User struct {
 	Username string `json:"username"`
 }
 
+// UserIdList defines model for UserIdList.
+type UserIdList = []string
+
+// UserMoveTeamRequest defines model for UserMoveTeamRequest.
+type UserMoveTeamRequest struct {
+	TeamName string `json:"team_name" validate:"required"`
+	UserId   string `json:"user_id"`
+}
+
+// UserTeamHistory defines model for UserTeamHistory.
+type UserTeamHistory struct {
+	Events []MembershipEvent `json:"events"`
+	UserId string            `json:"user_id"`
+}
+
+// Webhook defines model for Webhook.
+type Webhook struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// EventTypes Типы событий, на которые подписан webhook; пустой список — pull_request.created, pull_request.merged, reviewer.assigned и reviewer.unassigned.
+	// Остальные типы приходят, только если перечислены явно
+	EventTypes []EventType `json:"event_types"`
+	IsActive   bool        `json:"is_active"`
+	Url        string      `json:"url"`
+	WebhookId  string      `json:"webhook_id"`
+}
+
+// WebhookCreateRequest defines model for WebhookCreateRequest.
+type WebhookCreateRequest struct {
+	// EventTypes По умолчанию — pull_request.created, pull_request.merged, reviewer.assigned и reviewer.unassigned
+	EventTypes *[]EventType `json:"event_types,omitempty"`
+
+	// Url Абсолютный http(s) URL получателя
+	Url string `json:"url" validate:"required"`
+}
+
+// WebhookResponse defines model for WebhookResponse.
+type WebhookResponse struct {
+	Webhook Webhook `json:"webhook"`
+}
+
+// WebhookUpdateRequest defines model for WebhookUpdateRequest.
+type WebhookUpdateRequest struct {
+	EventTypes *[]EventType `json:"event_types,omitempty"`
+
+	// IsActive Неактивный webhook не получает событий; накопленные доставки отправляются после включения
+	IsActive  *bool   `json:"is_active,omitempty"`
+	Url       *string `json:"url,omitempty"`
+	WebhookId string  `json:"webhook_id"`
+}
+
+// PullRequestIdQuery defines model for PullRequestIdQuery.
+type PullRequestIdQuery = string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// BadRequest Единый формат ошибок. Кроме доменных кодов операций:
+// BAD_REQUEST — тело пустое или тело и параметры не разбираются, VALIDATION_FAILED — некорректные поля
+// (невалидный UUID, курсор, лимит, пустые обязательные строки), UNAUTHORIZED / FORBIDDEN — авторизация,
+// NOT_FOUND — неизвестный путь,
+// METHOD_NOT_ALLOWED (405) / UNSUPPORTED_MEDIA_TYPE (415) — метод или Content-Type не поддерживаются, INTERNAL — внутренняя ошибка,
+// IDEMPOTENCY_KEY_IN_PROGRESS (409) / IDEMPOTENCY_KEY_REUSED (422) — повтор запроса с Idempotency-Key,
+// PAYLOAD_TOO_LARGE (413) — слишком большое тело запроса.
+type BadRequest = ErrorResponse
+
+// Forbidden Единый формат ошибок. Кроме доменных кодов операций:
+// BAD_REQUEST — тело пустое или тело и параметры не разбираются, VALIDATION_FAILED — некорректные поля
+// (невалидный UUID, курсор, лимит, пустые обязательные строки), UNAUTHORIZED / FORBIDDEN — авторизация,
+// NOT_FOUND — неизвестный путь,
+// METHOD_NOT_ALLOWED (405) / UNSUPPORTED_MEDIA_TYPE (415) — метод или Content-Type не поддерживаются, INTERNAL — внутренняя ошибка,
+// IDEMPOTENCY_KEY_IN_PROGRESS (409) / IDEMPOTENCY_KEY_REUSED (422) — повтор запроса с Idempotency-Key,
+// PAYLOAD_TOO_LARGE (413) — слишком большое тело запроса.
+type Forbidden = ErrorResponse
+
+// InternalError Единый формат ошибок. Кроме доменных кодов операций:
+// BAD_REQUEST — тело пустое или тело и параметры не разбираются, VALIDATION_FAILED — некорректные поля
+// (невалидный UUID, курсор, лимит, пустые обязательные строки), UNAUTHORIZED / FORBIDDEN — авторизация,
+// NOT_FOUND — неизвестный путь,
+// METHOD_NOT_ALLOWED (405) / UNSUPPORTED_MEDIA_TYPE (415) — метод или Content-Type не поддерживаются, INTERNAL — внутренняя ошибка,
+// IDEMPOTENCY_KEY_IN_PROGRESS (409) / IDEMPOTENCY_KEY_REUSED (422) — повтор запроса с Idempotency-Key,
+// PAYLOAD_TOO_LARGE (413) — слишком большое тело запроса.
+type InternalError = ErrorResponse
+
+// Unauthorized Единый формат ошибок. Кроме доменных кодов операций:
+// BAD_REQUEST — тело пустое или тело и параметры не разбираются, VALIDATION_FAILED — некорректные поля
+// (невалидный UUID, курсор, лимит, пустые обязательные строки), UNAUTHORIZED / FORBIDDEN — авторизация,
+// NOT_FOUND — неизвестный путь,
+// METHOD_NOT_ALLOWED (405) / UNSUPPORTED_MEDIA_TYPE (415) — метод или Content-Type не поддерживаются, INTERNAL — внутренняя ошибка,
+// IDEMPOTENCY_KEY_IN_PROGRESS (409) / IDEMPOTENCY_KEY_REUSED (422) — повтор запроса с Idempotency-Key,
+// PAYLOAD_TOO_LARGE (413) — слишком большое тело запроса.
+type Unauthorized = ErrorResponse
+
+// PostAuthRevokeTokenJSONBody defines parameters for PostAuthRevokeToken.
+type PostAuthRevokeTokenJSONBody struct {
+	TokenId string `json:"token_id"`
+}
+
+// GetEventsParams defines parameters for GetEvents.
+type GetEventsParams struct {
+	// After Курсор события, после которого читать; по умолчанию — с начала ленты
+	After *string `form:"after,omitempty" json:"after,omitempty"`
+	Limit *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	// After Курсор, после которого начинать; по умолчанию — только новые события
+	After *string `form:"after,omitempty" json:"after,omitempty"`
+
+	// LastEventID Курсор последнего полученного события, важнее after
+	LastEventID *string `json:"Last-Event-ID,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
+	AuthorId string `json:"author_id"`
+
+	// Draft Создать черновик; ревьюверы назначаются после /pullRequest/ready
+	Draft           *bool  `json:"draft,omitempty"`
 	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	PullRequestName string `json:"pull_request_name" validate:"required"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	// PullRequestId Идентификатор PR
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestListParams defines parameters for GetPullRequestList.
+type GetPullRequestListParams struct {
+	Status   *GetPullRequestListParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	AuthorId *string                         `form:"author_id,omitempty" json:"author_id,omitempty"`
+
+	// TeamName Команда автора PR
+	TeamName   *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	ReviewerId *string `form:"reviewer_id,omitempty" json:"reviewer_id,omitempty"`
+
+	// CreatedAfter Включительно
+	CreatedAfter *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+
+	// CreatedBefore Не включительно
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Order Сортировка по created_at, затем по pull_request_id
+	Order *GetPullRequestListParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	Limit *int                           `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor из предыдущего ответа
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
 }
 
+// GetPullRequestListParamsStatus defines parameters for GetPullRequestList.
+type GetPullRequestListParamsStatus string
+
+// GetPullRequestListParamsOrder defines parameters for GetPullRequestList.
+type GetPullRequestListParamsOrder string
+
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// Force Смержить в обход политики команды (только с токеном admin)
+	Force         *bool  `json:"force,omitempty"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+	// NewUserId Кому передать ревью; должен быть активен, не автор, не назначен на PR и состоять в команде автора или её резервных командах. Без него замена выбирается стратегией команды
+	NewUserId     *string `json:"new_user_id,omitempty"`
+	OldUserId     string  `json:"old_user_id"`
+	PullRequestId string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	PullRequestId string                             `json:"pull_request_id"`
+	ReviewerId    string                             `json:"reviewer_id"`
+	State         PostPullRequestReviewJSONBodyState `json:"state"`
+}
+
+// PostPullRequestReviewJSONBodyState defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBodyState string
+
+// GetStatsTeamParams defines parameters for GetStatsTeam.
+type GetStatsTeamParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamFallbacksParams defines parameters for GetTeamFallbacks.
+type GetTeamFallbacksParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
 // GetTeamGetParams defines parameters for GetTeamGet.
@@ -125,10 +719,25 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamMergePolicyParams defines parameters for GetTeamMergePolicy.
+type GetTeamMergePolicyParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// ReviewState Например, PENDING — только ещё не просмотренные PR
+	ReviewState *ReviewState `form:"review_state,omitempty" json:"review_state,omitempty"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -137,44 +746,267 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// GetUsersTeamHistoryParams defines parameters for GetUsersTeamHistory.
+type GetUsersTeamHistoryParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	WebhookId string `json:"webhook_id"`
+}
+
+// PostAuthRevokeTokenJSONRequestBody defines body for PostAuthRevokeToken for application/json ContentType.
+type PostAuthRevokeTokenJSONRequestBody PostAuthRevokeTokenJSONBody
+
+// PostAuthTokenJSONRequestBody defines body for PostAuthToken for application/json ContentType.
+type PostAuthTokenJSONRequestBody = TokenRequest
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody = ReviewerChangeRequest
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody = PullRequestIdRequest
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody = PullRequestIdRequest
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody = ReviewerChangeRequest
+
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody = PullRequestIdRequest
+
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+type PostTeamAddJSONRequestBody = TeamAddRequest
+
+// PostTeamAddMembersJSONRequestBody defines body for PostTeamAddMembers for application/json ContentType.
+type PostTeamAddMembersJSONRequestBody = TeamMembersRequest
+
+// PostTeamDeactivateUsersJSONRequestBody defines body for PostTeamDeactivateUsers for application/json ContentType.
+type PostTeamDeactivateUsersJSONRequestBody = TeamDeactivateUsersRequest
+
+// PostTeamFallbacksJSONRequestBody defines body for PostTeamFallbacks for application/json ContentType.
+type PostTeamFallbacksJSONRequestBody = TeamFallbacks
+
+// PostTeamMergePolicyJSONRequestBody defines body for PostTeamMergePolicy for application/json ContentType.
+type PostTeamMergePolicyJSONRequestBody = MergePolicy
+
+// PostTeamRemoveMemberJSONRequestBody defines body for PostTeamRemoveMember for application/json ContentType.
+type PostTeamRemoveMemberJSONRequestBody = TeamRemoveMemberRequest
+
+// PostTeamRenameJSONRequestBody defines body for PostTeamRename for application/json ContentType.
+type PostTeamRenameJSONRequestBody = TeamRenameRequest
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody = TeamSettings
+
+// PostUsersMoveTeamJSONRequestBody defines body for PostUsersMoveTeam for application/json ContentType.
+type PostUsersMoveTeamJSONRequestBody = UserMoveTeamRequest
 
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostWebhooksJSONRequestBody defines body for PostWebhooks for application/json ContentType.
+type PostWebhooksJSONRequestBody = WebhookCreateRequest
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
+// PostWebhooksUpdateJSONRequestBody defines body for PostWebhooksUpdate for application/json ContentType.
+type PostWebhooksUpdateJSONRequestBody = WebhookUpdateRequest
+
+// AsUserIdList returns the union data inside the TeamDeactivateUsersRequest_UserIds as a UserIdList
+func (t TeamDeactivateUsersRequest_UserIds) AsUserIdList() (UserIdList, error) {
+	var body UserIdList
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromUserIdList overwrites any union data inside the TeamDeactivateUsersRequest_UserIds as the provided UserIdList
+func (t *TeamDeactivateUsersRequest_UserIds) FromUserIdList(v UserIdList) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeUserIdList performs a merge with any union data inside the TeamDeactivateUsersRequest_UserIds, using the provided UserIdList
+func (t *TeamDeactivateUsersRequest_UserIds) MergeUserIdList(v UserIdList) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsAllUsers returns the union data inside the TeamDeactivateUsersRequest_UserIds as a AllUsers
+func (t TeamDeactivateUsersRequest_UserIds) AsAllUsers() (AllUsers, error) {
+	var body AllUsers
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromAllUsers overwrites any union data inside the TeamDeactivateUsersRequest_UserIds as the provided AllUsers
+func (t *TeamDeactivateUsersRequest_UserIds) FromAllUsers(v AllUsers) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeAllUsers performs a merge with any union data inside the TeamDeactivateUsersRequest_UserIds, using the provided AllUsers
+func (t *TeamDeactivateUsersRequest_UserIds) MergeAllUsers(v AllUsers) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+func (t TeamDeactivateUsersRequest_UserIds) MarshalJSON() ([]byte, error) {
+	b, err := t.union.MarshalJSON()
+	return b, err
+}
+
+func (t *TeamDeactivateUsersRequest_UserIds) UnmarshalJSON(b []byte) error {
+	err := t.union.UnmarshalJSON(b)
+	return err
+}
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Отозвать API-токен
+	// (POST /auth/revokeToken)
+	PostAuthRevokeToken(ctx echo.Context) error
+	// Выпустить API-токен
+	// (POST /auth/token)
+	PostAuthToken(ctx echo.Context) error
+	// Лента событий по курсору
+	// (GET /events)
+	GetEvents(ctx echo.Context, params GetEventsParams) error
+	// Поток событий (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(ctx echo.Context, params GetEventsStreamParams) error
+	// Вручную назначить ревьювера (те же правила, что и при автоматическом выборе)
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx echo.Context) error
+	// Отклонить PR без мержа (DRAFT/OPEN → CLOSED, идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx echo.Context) error
+	// Создать PR и автоматически назначить до reviewers_required ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Получить PR с назначенными ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx echo.Context, params GetPullRequestGetParams) error
+	// История назначений ревьюверов PR (от старых событий к новым)
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
+	// Список PR с фильтрами и постраничной выдачей по курсору
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
+	// Перевести черновик в OPEN и назначить ревьюверов (DRAFT → OPEN)
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx echo.Context) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
-	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// Снять ревьювера без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx echo.Context) error
+	// Переоткрыть отклонённый PR (CLOSED → OPEN); ревьюверы назначаются, если их не было
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx echo.Context) error
+	// Оставить вердикт ревьювера (можно изменить до merge)
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx echo.Context) error
+	// Статистика назначений ревьюверов по всем командам
+	// (GET /stats)
+	GetStats(ctx echo.Context) error
+	// Статистика назначений ревьюверов в команде
+	// (GET /stats/team)
+	GetStatsTeam(ctx echo.Context, params GetStatsTeamParams) error
+	// Создать команду с участниками
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
+	// Добавить новых участников в существующую команду
+	// (POST /team/addMembers)
+	PostTeamAddMembers(ctx echo.Context) error
+	// Массово деактивировать участников команды и передать их открытые ревью оставшимся активным участникам
+	// (POST /team/deactivateUsers)
+	PostTeamDeactivateUsers(ctx echo.Context) error
+	// Получить резервные команды
+	// (GET /team/fallbacks)
+	GetTeamFallbacks(ctx echo.Context, params GetTeamFallbacksParams) error
+	// Задать резервные команды, из которых берутся ревьюверы, если в команде автора нет кандидатов
+	// (POST /team/fallbacks)
+	PostTeamFallbacks(ctx echo.Context) error
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Получить политику мержа команды
+	// (GET /team/mergePolicy)
+	GetTeamMergePolicy(ctx echo.Context, params GetTeamMergePolicyParams) error
+	// Изменить политику мержа команды
+	// (POST /team/mergePolicy)
+	PostTeamMergePolicy(ctx echo.Context) error
+	// Исключить участника из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx echo.Context) error
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(ctx echo.Context) error
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx echo.Context, params GetTeamSettingsParams) error
+	// Изменить стратегию выбора и число ревьюверов команды
+	// (POST /team/settings)
+	PostTeamSettings(ctx echo.Context) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
-	// Установить флаг активности пользователя
+	// Перевести пользователя в другую команду
+	// (POST /users/moveTeam)
+	PostUsersMoveTeam(ctx echo.Context) error
+	// Установить флаг активности пользователя (при деактивации открытые ревью переназначаются)
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// История переходов пользователя между командами (от старых событий к новым)
+	// (GET /users/teamHistory)
+	GetUsersTeamHistory(ctx echo.Context, params GetUsersTeamHistoryParams) error
+	// Список webhook-ов
+	// (GET /webhooks)
+	GetWebhooks(ctx echo.Context) error
+	// Зарегистрировать webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx echo.Context) error
+	// Удалить webhook
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx echo.Context) error
+	// Изменить webhook
+	// (POST /webhooks/update)
+	PostWebhooksUpdate(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -182,504 +1014,4179 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostPullRequestCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
+// PostAuthRevokeToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthRevokeToken(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(AdminAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestCreate(ctx)
+	err = w.Handler.PostAuthRevokeToken(ctx)
 	return err
 }
 
-// PostPullRequestMerge converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
+// PostAuthToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthToken(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(AdminAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestMerge(ctx)
+	err = w.Handler.PostAuthToken(ctx)
 	return err
 }
 
-// PostPullRequestReassign converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
+// GetEvents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEvents(ctx echo.Context) error {
 	var err error
 
-	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostPullRequestReassign(ctx)
-	return err
-}
+	ctx.Set(AdminAuthScopes, []string{})
 
-// PostTeamAdd converts echo context to params.
-func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
-	var err error
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsParams
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostTeamAdd(ctx)
+	err = w.Handler.GetEvents(ctx, params)
 	return err
 }
 
-// GetTeamGet converts echo context to params.
-func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
+// GetEventsStream converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEventsStream(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
-	// ------------- Required query parameter "team_name" -------------
+	var params GetEventsStreamParams
+	// ------------- Optional query parameter "after" -------------
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for Last-Event-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter Last-Event-ID: %s", err))
+		}
+
+		params.LastEventID = &LastEventID
 	}
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetTeamGet(ctx, params)
+	err = w.Handler.GetEventsStream(ctx, params)
 	return err
 }
 
-// GetUsersGetReview converts echo context to params.
-func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
+// PostPullRequestAddReviewer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestAddReviewer(ctx echo.Context) error {
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
-	// ------------- Required query parameter "user_id" -------------
+	ctx.Set(AdminAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
-	}
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.GetUsersGetReview(ctx, params)
+	err = w.Handler.PostPullRequestAddReviewer(ctx)
 	return err
 }
 
-// PostUsersSetIsActive converts echo context to params.
-func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
+// PostPullRequestClose converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestClose(ctx echo.Context) error {
 	var err error
 
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PostUsersSetIsActive(ctx)
+	err = w.Handler.PostPullRequestClose(ctx)
 	return err
 }
 
-// This is a simple interface which specifies echo.Route addition functions which
-// are present on both echo.Echo and echo.Group, since we want to allow using
-// either of them for path registration
-type EchoRouter interface {
-	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
-}
+// PostPullRequestCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
+	var err error
 
-// RegisterHandlers adds each server route to the EchoRouter.
-func RegisterHandlers(router EchoRouter, si ServerInterface) {
-	RegisterHandlersWithBaseURL(router, si, "")
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestCreate(ctx)
+	return err
 }
 
-// Registers handlers, and prepends BaseURL to the paths, so that the paths
-// can be served under a prefix.
-func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+// GetPullRequestGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestGet(ctx echo.Context) error {
+	var err error
 
-	wrapper := ServerInterfaceWrapper{
-		Handler: si,
-	}
+	ctx.Set(AdminAuthScopes, []string{})
 
-	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
-	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
-	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-}
+	ctx.Set(UserAuthScopes, []string{})
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+	// ------------- Required query parameter "pull_request_id" -------------
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestGet(ctx, params)
+	return err
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(AdminAuthScopes, []string{})
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx.Set(UserAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// GetPullRequestList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestList(ctx echo.Context) error {
+	var err error
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	ctx.Set(AdminAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+	ctx.Set(UserAuthScopes, []string{})
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestListParams
+	// ------------- Optional query parameter "status" -------------
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
-}
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// ------------- Optional query parameter "author_id" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "author_id", ctx.QueryParams(), &params.AuthorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter author_id: %s", err))
+	}
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+	// ------------- Optional query parameter "team_name" -------------
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "reviewer_id" -------------
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "reviewer_id", ctx.QueryParams(), &params.ReviewerId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter reviewer_id: %s", err))
+	}
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
-}
+	// ------------- Optional query parameter "created_after" -------------
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	// ------------- Optional query parameter "created_before" -------------
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	err = runtime.BindQueryParameter("form", true, false, "created_before", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_before: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Optional query parameter "order" -------------
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	// ------------- Optional query parameter "limit" -------------
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	// ------------- Optional query parameter "cursor" -------------
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestList(ctx, params)
+	return err
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+// PostPullRequestMerge converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
+	var err error
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	ctx.Set(AdminAuthScopes, []string{})
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
-}
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	ctx.Set(UserAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestMerge(ctx)
+	return err
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PostPullRequestReady converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReady(ctx echo.Context) error {
+	var err error
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	ctx.Set(AdminAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
-}
+	ctx.Set(UserAuthScopes, []string{})
 
-type GetTeamGetResponseObject interface {
-	VisitGetTeamGetResponse(w http.ResponseWriter) error
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReady(ctx)
+	return err
 }
 
-type GetTeamGet200JSONResponse Team
+// PostPullRequestReassign converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
+	var err error
 
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	ctx.Set(AdminAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReassign(ctx)
+	return err
 }
 
-type GetTeamGet404JSONResponse ErrorResponse
+// PostPullRequestRemoveReviewer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(ctx echo.Context) error {
+	var err error
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	ctx.Set(AdminAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	ctx.Set(TeamLeadAuthScopes, []string{})
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestRemoveReviewer(ctx)
+	return err
 }
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+// PostPullRequestReopen converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReopen(ctx echo.Context) error {
+	var err error
 
-type GetUsersGetReview200JSONResponse struct {
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReopen(ctx)
+	return err
+}
+
+// PostPullRequestReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReview(ctx)
+	return err
+}
+
+// GetStats converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStats(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStats(ctx)
+	return err
+}
+
+// GetStatsTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsTeam(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsTeamParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsTeam(ctx, params)
+	return err
+}
+
+// PostTeamAdd converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamAdd(ctx)
+	return err
+}
+
+// PostTeamAddMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamAddMembers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamAddMembers(ctx)
+	return err
+}
+
+// PostTeamDeactivateUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamDeactivateUsers(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamDeactivateUsers(ctx)
+	return err
+}
+
+// GetTeamFallbacks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamFallbacks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamFallbacksParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamFallbacks(ctx, params)
+	return err
+}
+
+// PostTeamFallbacks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamFallbacks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamFallbacks(ctx)
+	return err
+}
+
+// GetTeamGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamGet(ctx, params)
+	return err
+}
+
+// GetTeamMergePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamMergePolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamMergePolicyParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamMergePolicy(ctx, params)
+	return err
+}
+
+// PostTeamMergePolicy converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamMergePolicy(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamMergePolicy(ctx)
+	return err
+}
+
+// PostTeamRemoveMember converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRemoveMember(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRemoveMember(ctx)
+	return err
+}
+
+// PostTeamRename converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRename(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRename(ctx)
+	return err
+}
+
+// GetTeamSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamSettings(ctx, params)
+	return err
+}
+
+// PostTeamSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSettings(ctx)
+	return err
+}
+
+// GetUsersGetReview converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	ctx.Set(UserAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "review_state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "review_state", ctx.QueryParams(), &params.ReviewState)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter review_state: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetReview(ctx, params)
+	return err
+}
+
+// PostUsersMoveTeam converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersMoveTeam(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersMoveTeam(ctx)
+	return err
+}
+
+// PostUsersSetIsActive converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetIsActive(ctx)
+	return err
+}
+
+// GetUsersTeamHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersTeamHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(TeamLeadAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersTeamHistoryParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersTeamHistory(ctx, params)
+	return err
+}
+
+// GetWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooks(ctx)
+	return err
+}
+
+// PostWebhooks converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooks(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooks(ctx)
+	return err
+}
+
+// PostWebhooksDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksDelete(ctx)
+	return err
+}
+
+// PostWebhooksUpdate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksUpdate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksUpdate(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.POST(baseURL+"/auth/revokeToken", wrapper.PostAuthRevokeToken)
+	router.POST(baseURL+"/auth/token", wrapper.PostAuthToken)
+	router.GET(baseURL+"/events", wrapper.GetEvents)
+	router.GET(baseURL+"/events/stream", wrapper.GetEventsStream)
+	router.POST(baseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	router.POST(baseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(baseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	router.GET(baseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
+	router.GET(baseURL+"/pullRequest/list", wrapper.GetPullRequestList)
+	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(baseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	router.POST(baseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(baseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
+	router.GET(baseURL+"/stats", wrapper.GetStats)
+	router.GET(baseURL+"/stats/team", wrapper.GetStatsTeam)
+	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
+	router.POST(baseURL+"/team/addMembers", wrapper.PostTeamAddMembers)
+	router.POST(baseURL+"/team/deactivateUsers", wrapper.PostTeamDeactivateUsers)
+	router.GET(baseURL+"/team/fallbacks", wrapper.GetTeamFallbacks)
+	router.POST(baseURL+"/team/fallbacks", wrapper.PostTeamFallbacks)
+	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(baseURL+"/team/mergePolicy", wrapper.GetTeamMergePolicy)
+	router.POST(baseURL+"/team/mergePolicy", wrapper.PostTeamMergePolicy)
+	router.POST(baseURL+"/team/removeMember", wrapper.PostTeamRemoveMember)
+	router.POST(baseURL+"/team/rename", wrapper.PostTeamRename)
+	router.GET(baseURL+"/team/settings", wrapper.GetTeamSettings)
+	router.POST(baseURL+"/team/settings", wrapper.PostTeamSettings)
+	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.POST(baseURL+"/users/moveTeam", wrapper.PostUsersMoveTeam)
+	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.GET(baseURL+"/users/teamHistory", wrapper.GetUsersTeamHistory)
+	router.GET(baseURL+"/webhooks", wrapper.GetWebhooks)
+	router.POST(baseURL+"/webhooks", wrapper.PostWebhooks)
+	router.POST(baseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	router.POST(baseURL+"/webhooks/update", wrapper.PostWebhooksUpdate)
+
+}
+
+type BadRequestJSONResponse ErrorResponse
+
+type ForbiddenJSONResponse ErrorResponse
+
+type InternalErrorJSONResponse ErrorResponse
+
+type UnauthorizedJSONResponse ErrorResponse
+
+type PostAuthRevokeTokenRequestObject struct {
+	Body *PostAuthRevokeTokenJSONRequestBody
+}
+
+type PostAuthRevokeTokenResponseObject interface {
+	VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error
+}
+
+type PostAuthRevokeToken200JSONResponse struct {
+	ApiToken APIToken `json:"api_token"`
+}
+
+func (response PostAuthRevokeToken200JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRevokeToken400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostAuthRevokeToken400JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRevokeToken401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostAuthRevokeToken401JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRevokeToken403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostAuthRevokeToken403JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRevokeToken404JSONResponse ErrorResponse
+
+func (response PostAuthRevokeToken404JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthRevokeToken500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAuthRevokeToken500JSONResponse) VisitPostAuthRevokeTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthTokenRequestObject struct {
+	Body *PostAuthTokenJSONRequestBody
+}
+
+type PostAuthTokenResponseObject interface {
+	VisitPostAuthTokenResponse(w http.ResponseWriter) error
+}
+
+type PostAuthToken201JSONResponse struct {
+	ApiToken APIToken `json:"api_token"`
+	Token    string   `json:"token"`
+}
+
+func (response PostAuthToken201JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken400JSONResponse ErrorResponse
+
+func (response PostAuthToken400JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostAuthToken401JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostAuthToken403JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken404JSONResponse ErrorResponse
+
+func (response PostAuthToken404JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostAuthToken500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostAuthToken500JSONResponse) VisitPostAuthTokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsRequestObject struct {
+	Params GetEventsParams
+}
+
+type GetEventsResponseObject interface {
+	VisitGetEventsResponse(w http.ResponseWriter) error
+}
+
+type GetEvents200JSONResponse EventPage
+
+func (response GetEvents200JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetEvents400JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEvents401JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEvents403JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEvents500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetEvents500JSONResponse) VisitGetEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStreamRequestObject struct {
+	Params GetEventsStreamParams
+}
+
+type GetEventsStreamResponseObject interface {
+	VisitGetEventsStreamResponse(w http.ResponseWriter) error
+}
+
+type GetEventsStream200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response GetEventsStream200TexteventStreamResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type GetEventsStream400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetEventsStream400JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetEventsStream401JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetEventsStream403JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetEventsStream500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetEventsStream500JSONResponse) VisitGetEventsStreamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Body *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200JSONResponse PullRequestResponse
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestAddReviewer400JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestAddReviewer401JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestAddReviewer403JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestAddReviewer500JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse PullRequestResponse
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestClose400JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestClose401JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestClose403JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestClose500JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestCreate400JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestCreate401JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestCreate403JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestCreate500JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200JSONResponse PullRequest
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequestGet400JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPullRequestGet401JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPullRequestGet403JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPullRequestGet500JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistoryRequestObject struct {
+	Params GetPullRequestHistoryParams
+}
+
+type GetPullRequestHistoryResponseObject interface {
+	VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestHistory200JSONResponse PullRequestHistory
+
+func (response GetPullRequestHistory200JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequestHistory400JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPullRequestHistory401JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPullRequestHistory403JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory404JSONResponse ErrorResponse
+
+func (response GetPullRequestHistory404JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestHistory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPullRequestHistory500JSONResponse) VisitGetPullRequestHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestListRequestObject struct {
+	Params GetPullRequestListParams
+}
+
+type GetPullRequestListResponseObject interface {
+	VisitGetPullRequestListResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestList200JSONResponse struct {
+	// NextCursor Отсутствует на последней странице
+	NextCursor   *string            `json:"next_cursor,omitempty"`
+	PullRequests []PullRequestShort `json:"pull_requests"`
+}
+
+func (response GetPullRequestList200JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetPullRequestList400JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetPullRequestList401JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetPullRequestList403JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList404JSONResponse ErrorResponse
+
+func (response GetPullRequestList404JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestList500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetPullRequestList500JSONResponse) VisitGetPullRequestListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestMerge400JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestMerge401JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge403JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge403JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestMerge500JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Body *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse PullRequestResponse
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestReady400JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestReady401JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestReady403JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestReady500JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestReassign400JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestReassign401JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestReassign403JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestReassign500JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Body *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse PullRequestResponse
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestRemoveReviewer400JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestRemoveReviewer401JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestRemoveReviewer403JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestRemoveReviewer500JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse PullRequestResponse
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostPullRequestReopen400JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestReopen401JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestReopen403JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestReopen500JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReviewRequestObject struct {
+	Body *PostPullRequestReviewJSONRequestBody
+}
+
+type PostPullRequestReviewResponseObject interface {
+	VisitPostPullRequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReview200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+}
+
+func (response PostPullRequestReview200JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestReview400JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostPullRequestReview401JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostPullRequestReview403JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestReview404JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestReview409JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReview500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostPullRequestReview500JSONResponse) VisitPostPullRequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsRequestObject struct {
+}
+
+type GetStatsResponseObject interface {
+	VisitGetStatsResponse(w http.ResponseWriter) error
+}
+
+type GetStats200JSONResponse Stats
+
+func (response GetStats200JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetStats401JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetStats403JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStats500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetStats500JSONResponse) VisitGetStatsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeamRequestObject struct {
+	Params GetStatsTeamParams
+}
+
+type GetStatsTeamResponseObject interface {
+	VisitGetStatsTeamResponse(w http.ResponseWriter) error
+}
+
+type GetStatsTeam200JSONResponse Stats
+
+func (response GetStatsTeam200JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetStatsTeam400JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeam401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetStatsTeam401JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetStatsTeam403JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeam404JSONResponse ErrorResponse
+
+func (response GetStatsTeam404JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsTeam500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetStatsTeam500JSONResponse) VisitGetStatsTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	// Moved Пользователи, переведённые из других команд
+	Moved *[]MembershipChange `json:"moved,omitempty"`
+	Team  *Team               `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamAdd401JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamAdd403JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd409JSONResponse ErrorResponse
+
+func (response PostTeamAdd409JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamAdd500JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembersRequestObject struct {
+	Body *PostTeamAddMembersJSONRequestBody
+}
+
+type PostTeamAddMembersResponseObject interface {
+	VisitPostTeamAddMembersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAddMembers200JSONResponse Team
+
+func (response PostTeamAddMembers200JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamAddMembers400JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamAddMembers401JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamAddMembers403JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers404JSONResponse ErrorResponse
+
+func (response PostTeamAddMembers404JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers409JSONResponse ErrorResponse
+
+func (response PostTeamAddMembers409JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddMembers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamAddMembers500JSONResponse) VisitPostTeamAddMembersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsersRequestObject struct {
+	Body *PostTeamDeactivateUsersJSONRequestBody
+}
+
+type PostTeamDeactivateUsersResponseObject interface {
+	VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error
+}
+
+type PostTeamDeactivateUsers200JSONResponse TeamDeactivation
+
+func (response PostTeamDeactivateUsers200JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamDeactivateUsers400JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamDeactivateUsers401JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamDeactivateUsers403JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers404JSONResponse ErrorResponse
+
+func (response PostTeamDeactivateUsers404JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamDeactivateUsers500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamDeactivateUsers500JSONResponse) VisitPostTeamDeactivateUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacksRequestObject struct {
+	Params GetTeamFallbacksParams
+}
+
+type GetTeamFallbacksResponseObject interface {
+	VisitGetTeamFallbacksResponse(w http.ResponseWriter) error
+}
+
+type GetTeamFallbacks200JSONResponse TeamFallbacks
+
+func (response GetTeamFallbacks200JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacks400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamFallbacks400JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeamFallbacks401JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeamFallbacks403JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacks404JSONResponse ErrorResponse
+
+func (response GetTeamFallbacks404JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamFallbacks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamFallbacks500JSONResponse) VisitGetTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacksRequestObject struct {
+	Body *PostTeamFallbacksJSONRequestBody
+}
+
+type PostTeamFallbacksResponseObject interface {
+	VisitPostTeamFallbacksResponse(w http.ResponseWriter) error
+}
+
+type PostTeamFallbacks200JSONResponse TeamFallbacks
+
+func (response PostTeamFallbacks200JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacks400JSONResponse ErrorResponse
+
+func (response PostTeamFallbacks400JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamFallbacks401JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamFallbacks403JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacks404JSONResponse ErrorResponse
+
+func (response PostTeamFallbacks404JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamFallbacks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamFallbacks500JSONResponse) VisitPostTeamFallbacksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
+}
+
+type GetTeamGetResponseObject interface {
+	VisitGetTeamGetResponse(w http.ResponseWriter) error
+}
+
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamGet400JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeamGet401JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeamGet403JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamGet500JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicyRequestObject struct {
+	Params GetTeamMergePolicyParams
+}
+
+type GetTeamMergePolicyResponseObject interface {
+	VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error
+}
+
+type GetTeamMergePolicy200JSONResponse MergePolicy
+
+func (response GetTeamMergePolicy200JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamMergePolicy400JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeamMergePolicy401JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeamMergePolicy403JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy404JSONResponse ErrorResponse
+
+func (response GetTeamMergePolicy404JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamMergePolicy500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamMergePolicy500JSONResponse) VisitGetTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicyRequestObject struct {
+	Body *PostTeamMergePolicyJSONRequestBody
+}
+
+type PostTeamMergePolicyResponseObject interface {
+	VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamMergePolicy200JSONResponse MergePolicy
+
+func (response PostTeamMergePolicy200JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicy400JSONResponse ErrorResponse
+
+func (response PostTeamMergePolicy400JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamMergePolicy401JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamMergePolicy403JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicy404JSONResponse ErrorResponse
+
+func (response PostTeamMergePolicy404JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamMergePolicy500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamMergePolicy500JSONResponse) VisitPostTeamMergePolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMemberRequestObject struct {
+	Body *PostTeamRemoveMemberJSONRequestBody
+}
+
+type PostTeamRemoveMemberResponseObject interface {
+	VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRemoveMember200JSONResponse MembershipChange
+
+func (response PostTeamRemoveMember200JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamRemoveMember400JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamRemoveMember401JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamRemoveMember403JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember404JSONResponse ErrorResponse
+
+func (response PostTeamRemoveMember404JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRemoveMember500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamRemoveMember500JSONResponse) VisitPostTeamRemoveMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRenameRequestObject struct {
+	Body *PostTeamRenameJSONRequestBody
+}
+
+type PostTeamRenameResponseObject interface {
+	VisitPostTeamRenameResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRename200JSONResponse Team
+
+func (response PostTeamRename200JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostTeamRename400JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamRename401JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamRename403JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename404JSONResponse ErrorResponse
+
+func (response PostTeamRename404JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename409JSONResponse ErrorResponse
+
+func (response PostTeamRename409JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRename500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamRename500JSONResponse) VisitPostTeamRenameResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsRequestObject struct {
+	Params GetTeamSettingsParams
+}
+
+type GetTeamSettingsResponseObject interface {
+	VisitGetTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSettings200JSONResponse TeamSettings
+
+func (response GetTeamSettings200JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetTeamSettings400JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetTeamSettings401JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetTeamSettings403JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings404JSONResponse ErrorResponse
+
+func (response GetTeamSettings404JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetTeamSettings500JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRequestObject struct {
+	Body *PostTeamSettingsJSONRequestBody
+}
+
+type PostTeamSettingsResponseObject interface {
+	VisitPostTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSettings200JSONResponse TeamSettings
+
+func (response PostTeamSettings200JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings400JSONResponse ErrorResponse
+
+func (response PostTeamSettings400JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostTeamSettings401JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostTeamSettings403JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings404JSONResponse ErrorResponse
+
+func (response PostTeamSettings404JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostTeamSettings500JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
 	PullRequests []PullRequestShort `json:"pull_requests"`
 	UserId       string             `json:"user_id"`
 }
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUsersGetReview400JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUsersGetReview401JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUsersGetReview403JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReview500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersGetReview500JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeamRequestObject struct {
+	Body *PostUsersMoveTeamJSONRequestBody
+}
+
+type PostUsersMoveTeamResponseObject interface {
+	VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error
+}
+
+type PostUsersMoveTeam200JSONResponse MembershipChange
+
+func (response PostUsersMoveTeam200JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersMoveTeam400JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostUsersMoveTeam401JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostUsersMoveTeam403JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam404JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam404JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam409JSONResponse ErrorResponse
+
+func (response PostUsersMoveTeam409JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersMoveTeam500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersMoveTeam500JSONResponse) VisitPostUsersMoveTeamResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	// NotReassigned Открытые PR без доступных кандидатов, пользователь остаётся ревьювером
+	NotReassigned []string `json:"not_reassigned"`
+
+	// Reassigned Открытые PR, переданные другим ревьюверам
+	Reassigned []ReviewReassignment `json:"reassigned"`
+	User       *User                `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostUsersSetIsActive400JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostUsersSetIsActive401JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostUsersSetIsActive403JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostUsersSetIsActive500JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistoryRequestObject struct {
+	Params GetUsersTeamHistoryParams
+}
+
+type GetUsersTeamHistoryResponseObject interface {
+	VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetUsersTeamHistory200JSONResponse UserTeamHistory
+
+func (response GetUsersTeamHistory200JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistory400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetUsersTeamHistory400JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistory401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetUsersTeamHistory401JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistory403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetUsersTeamHistory403JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistory404JSONResponse ErrorResponse
+
+func (response GetUsersTeamHistory404JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersTeamHistory500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetUsersTeamHistory500JSONResponse) VisitGetUsersTeamHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooksRequestObject struct {
+}
+
+type GetWebhooksResponseObject interface {
+	VisitGetWebhooksResponse(w http.ResponseWriter) error
+}
+
+type GetWebhooks200JSONResponse struct {
+	Webhooks []Webhook `json:"webhooks"`
+}
+
+func (response GetWebhooks200JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetWebhooks401JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetWebhooks403JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWebhooks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response GetWebhooks500JSONResponse) VisitGetWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksRequestObject struct {
+	Body *PostWebhooksJSONRequestBody
+}
+
+type PostWebhooksResponseObject interface {
+	VisitPostWebhooksResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooks201JSONResponse struct {
+	Secret  string  `json:"secret"`
+	Webhook Webhook `json:"webhook"`
+}
+
+func (response PostWebhooks201JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks400JSONResponse ErrorResponse
+
+func (response PostWebhooks400JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostWebhooks401JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostWebhooks403JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooks500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostWebhooks500JSONResponse) VisitPostWebhooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDeleteRequestObject struct {
+	Body *PostWebhooksDeleteJSONRequestBody
+}
+
+type PostWebhooksDeleteResponseObject interface {
+	VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksDelete200JSONResponse WebhookResponse
+
+func (response PostWebhooksDelete200JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDelete400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PostWebhooksDelete400JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDelete401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostWebhooksDelete401JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDelete403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostWebhooksDelete403JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDelete404JSONResponse ErrorResponse
+
+func (response PostWebhooksDelete404JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksDelete500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostWebhooksDelete500JSONResponse) VisitPostWebhooksDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdateRequestObject struct {
+	Body *PostWebhooksUpdateJSONRequestBody
+}
+
+type PostWebhooksUpdateResponseObject interface {
+	VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostWebhooksUpdate200JSONResponse WebhookResponse
+
+func (response PostWebhooksUpdate200JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdate400JSONResponse ErrorResponse
+
+func (response PostWebhooksUpdate400JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdate401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PostWebhooksUpdate401JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdate403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PostWebhooksUpdate403JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdate404JSONResponse ErrorResponse
+
+func (response PostWebhooksUpdate404JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostWebhooksUpdate500JSONResponse struct{ InternalErrorJSONResponse }
+
+func (response PostWebhooksUpdate500JSONResponse) VisitPostWebhooksUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Отозвать API-токен
+	// (POST /auth/revokeToken)
+	PostAuthRevokeToken(ctx context.Context, request PostAuthRevokeTokenRequestObject) (PostAuthRevokeTokenResponseObject, error)
+	// Выпустить API-токен
+	// (POST /auth/token)
+	PostAuthToken(ctx context.Context, request PostAuthTokenRequestObject) (PostAuthTokenResponseObject, error)
+	// Лента событий по курсору
+	// (GET /events)
+	GetEvents(ctx context.Context, request GetEventsRequestObject) (GetEventsResponseObject, error)
+	// Поток событий (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(ctx context.Context, request GetEventsStreamRequestObject) (GetEventsStreamResponseObject, error)
+	// Вручную назначить ревьювера (те же правила, что и при автоматическом выборе)
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Отклонить PR без мержа (DRAFT/OPEN → CLOSED, идемпотентная операция)
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить до reviewers_required ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Получить PR с назначенными ревьюверами
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// История назначений ревьюверов PR (от старых событий к новым)
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx context.Context, request GetPullRequestHistoryRequestObject) (GetPullRequestHistoryResponseObject, error)
+	// Список PR с фильтрами и постраничной выдачей по курсору
+	// (GET /pullRequest/list)
+	GetPullRequestList(ctx context.Context, request GetPullRequestListRequestObject) (GetPullRequestListResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Перевести черновик в OPEN и назначить ревьюверов (DRAFT → OPEN)
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Снять ревьювера без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Переоткрыть отклонённый PR (CLOSED → OPEN); ревьюверы назначаются, если их не было
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Оставить вердикт ревьювера (можно изменить до merge)
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx context.Context, request PostPullRequestReviewRequestObject) (PostPullRequestReviewResponseObject, error)
+	// Статистика назначений ревьюверов по всем командам
+	// (GET /stats)
+	GetStats(ctx context.Context, request GetStatsRequestObject) (GetStatsResponseObject, error)
+	// Статистика назначений ревьюверов в команде
+	// (GET /stats/team)
+	GetStatsTeam(ctx context.Context, request GetStatsTeamRequestObject) (GetStatsTeamResponseObject, error)
+	// Создать команду с участниками
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Добавить новых участников в существующую команду
+	// (POST /team/addMembers)
+	PostTeamAddMembers(ctx context.Context, request PostTeamAddMembersRequestObject) (PostTeamAddMembersResponseObject, error)
+	// Массово деактивировать участников команды и передать их открытые ревью оставшимся активным участникам
+	// (POST /team/deactivateUsers)
+	PostTeamDeactivateUsers(ctx context.Context, request PostTeamDeactivateUsersRequestObject) (PostTeamDeactivateUsersResponseObject, error)
+	// Получить резервные команды
+	// (GET /team/fallbacks)
+	GetTeamFallbacks(ctx context.Context, request GetTeamFallbacksRequestObject) (GetTeamFallbacksResponseObject, error)
+	// Задать резервные команды, из которых берутся ревьюверы, если в команде автора нет кандидатов
+	// (POST /team/fallbacks)
+	PostTeamFallbacks(ctx context.Context, request PostTeamFallbacksRequestObject) (PostTeamFallbacksResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Получить политику мержа команды
+	// (GET /team/mergePolicy)
+	GetTeamMergePolicy(ctx context.Context, request GetTeamMergePolicyRequestObject) (GetTeamMergePolicyResponseObject, error)
+	// Изменить политику мержа команды
+	// (POST /team/mergePolicy)
+	PostTeamMergePolicy(ctx context.Context, request PostTeamMergePolicyRequestObject) (PostTeamMergePolicyResponseObject, error)
+	// Исключить участника из команды
+	// (POST /team/removeMember)
+	PostTeamRemoveMember(ctx context.Context, request PostTeamRemoveMemberRequestObject) (PostTeamRemoveMemberResponseObject, error)
+	// Переименовать команду
+	// (POST /team/rename)
+	PostTeamRename(ctx context.Context, request PostTeamRenameRequestObject) (PostTeamRenameResponseObject, error)
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx context.Context, request GetTeamSettingsRequestObject) (GetTeamSettingsResponseObject, error)
+	// Изменить стратегию выбора и число ревьюверов команды
+	// (POST /team/settings)
+	PostTeamSettings(ctx context.Context, request PostTeamSettingsRequestObject) (PostTeamSettingsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Перевести пользователя в другую команду
+	// (POST /users/moveTeam)
+	PostUsersMoveTeam(ctx context.Context, request PostUsersMoveTeamRequestObject) (PostUsersMoveTeamResponseObject, error)
+	// Установить флаг активности пользователя (при деактивации открытые ревью переназначаются)
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// История переходов пользователя между командами (от старых событий к новым)
+	// (GET /users/teamHistory)
+	GetUsersTeamHistory(ctx context.Context, request GetUsersTeamHistoryRequestObject) (GetUsersTeamHistoryResponseObject, error)
+	// Список webhook-ов
+	// (GET /webhooks)
+	GetWebhooks(ctx context.Context, request GetWebhooksRequestObject) (GetWebhooksResponseObject, error)
+	// Зарегистрировать webhook
+	// (POST /webhooks)
+	PostWebhooks(ctx context.Context, request PostWebhooksRequestObject) (PostWebhooksResponseObject, error)
+	// Удалить webhook
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx context.Context, request PostWebhooksDeleteRequestObject) (PostWebhooksDeleteResponseObject, error)
+	// Изменить webhook
+	// (POST /webhooks/update)
+	PostWebhooksUpdate(ctx context.Context, request PostWebhooksUpdateRequestObject) (PostWebhooksUpdateResponseObject, error)
+}
+
+type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
+type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostAuthRevokeToken operation middleware
+func (sh *strictHandler) PostAuthRevokeToken(ctx echo.Context) error {
+	var request PostAuthRevokeTokenRequestObject
+
+	var body PostAuthRevokeTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthRevokeToken(ctx.Request().Context(), request.(PostAuthRevokeTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthRevokeToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthRevokeTokenResponseObject); ok {
+		return validResponse.VisitPostAuthRevokeTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostAuthToken operation middleware
+func (sh *strictHandler) PostAuthToken(ctx echo.Context) error {
+	var request PostAuthTokenRequestObject
+
+	var body PostAuthTokenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostAuthToken(ctx.Request().Context(), request.(PostAuthTokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostAuthToken")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostAuthTokenResponseObject); ok {
+		return validResponse.VisitPostAuthTokenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetEvents operation middleware
+func (sh *strictHandler) GetEvents(ctx echo.Context, params GetEventsParams) error {
+	var request GetEventsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEvents(ctx.Request().Context(), request.(GetEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEvents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEventsResponseObject); ok {
+		return validResponse.VisitGetEventsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetEventsStream operation middleware
+func (sh *strictHandler) GetEventsStream(ctx echo.Context, params GetEventsStreamParams) error {
+	var request GetEventsStreamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetEventsStream(ctx.Request().Context(), request.(GetEventsStreamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetEventsStream")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetEventsStreamResponseObject); ok {
+		return validResponse.VisitGetEventsStreamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(ctx echo.Context) error {
+	var request PostPullRequestAddReviewerRequestObject
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx.Request().Context(), request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		return validResponse.VisitPostPullRequestAddReviewerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(ctx echo.Context) error {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx.Request().Context(), request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		return validResponse.VisitPostPullRequestCloseResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(ctx echo.Context) error {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx.Request().Context(), request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		return validResponse.VisitPostPullRequestCreateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(ctx echo.Context, params GetPullRequestGetParams) error {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx.Request().Context(), request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		return validResponse.VisitGetPullRequestGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPullRequestHistory operation middleware
+func (sh *strictHandler) GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error {
+	var request GetPullRequestHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestHistory(ctx.Request().Context(), request.(GetPullRequestHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPullRequestHistoryResponseObject); ok {
+		return validResponse.VisitGetPullRequestHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetPullRequestList operation middleware
+func (sh *strictHandler) GetPullRequestList(ctx echo.Context, params GetPullRequestListParams) error {
+	var request GetPullRequestListRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestList(ctx.Request().Context(), request.(GetPullRequestListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetPullRequestListResponseObject); ok {
+		return validResponse.VisitGetPullRequestListResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(ctx echo.Context) error {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx.Request().Context(), request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		return validResponse.VisitPostPullRequestMergeResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(ctx echo.Context) error {
+	var request PostPullRequestReadyRequestObject
 
-	return json.NewEncoder(w).Encode(response)
+	var body PostPullRequestReadyJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx.Request().Context(), request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		return validResponse.VisitPostPullRequestReadyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(ctx echo.Context) error {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx.Request().Context(), request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		return validResponse.VisitPostPullRequestReassignResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(ctx echo.Context) error {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx.Request().Context(), request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		return validResponse.VisitPostPullRequestRemoveReviewerResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(ctx echo.Context) error {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx.Request().Context(), request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		return validResponse.VisitPostPullRequestReopenResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostPullRequestReview operation middleware
+func (sh *strictHandler) PostPullRequestReview(ctx echo.Context) error {
+	var request PostPullRequestReviewRequestObject
+
+	var body PostPullRequestReviewJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReview(ctx.Request().Context(), request.(PostPullRequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostPullRequestReviewResponseObject); ok {
+		return validResponse.VisitPostPullRequestReviewResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetStats operation middleware
+func (sh *strictHandler) GetStats(ctx echo.Context) error {
+	var request GetStatsRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStats(ctx.Request().Context(), request.(GetStatsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStats")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetStatsResponseObject); ok {
+		return validResponse.VisitGetStatsResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetStatsTeam operation middleware
+func (sh *strictHandler) GetStatsTeam(ctx echo.Context, params GetStatsTeamParams) error {
+	var request GetStatsTeamRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsTeam(ctx.Request().Context(), request.(GetStatsTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetStatsTeamResponseObject); ok {
+		return validResponse.VisitGetStatsTeamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(ctx echo.Context) error {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx.Request().Context(), request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		return validResponse.VisitPostTeamAddResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamAddMembers operation middleware
+func (sh *strictHandler) PostTeamAddMembers(ctx echo.Context) error {
+	var request PostTeamAddMembersRequestObject
+
+	var body PostTeamAddMembersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAddMembers(ctx.Request().Context(), request.(PostTeamAddMembersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAddMembers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamAddMembersResponseObject); ok {
+		return validResponse.VisitPostTeamAddMembersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostTeamDeactivateUsers operation middleware
+func (sh *strictHandler) PostTeamDeactivateUsers(ctx echo.Context) error {
+	var request PostTeamDeactivateUsersRequestObject
+
+	var body PostTeamDeactivateUsersJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamDeactivateUsers(ctx.Request().Context(), request.(PostTeamDeactivateUsersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamDeactivateUsers")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamDeactivateUsersResponseObject); ok {
+		return validResponse.VisitPostTeamDeactivateUsersResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetTeamFallbacks operation middleware
+func (sh *strictHandler) GetTeamFallbacks(ctx echo.Context, params GetTeamFallbacksParams) error {
+	var request GetTeamFallbacksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamFallbacks(ctx.Request().Context(), request.(GetTeamFallbacksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamFallbacks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTeamFallbacksResponseObject); ok {
+		return validResponse.VisitGetTeamFallbacksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+// PostTeamFallbacks operation middleware
+func (sh *strictHandler) PostTeamFallbacks(ctx echo.Context) error {
+	var request PostTeamFallbacksRequestObject
+
+	var body PostTeamFallbacksJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamFallbacks(ctx.Request().Context(), request.(PostTeamFallbacksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamFallbacks")
+	}
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	response, err := handler(ctx, request)
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostTeamFallbacksResponseObject); ok {
+		return validResponse.VisitPostTeamFallbacksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(ctx echo.Context, params GetTeamGetParams) error {
+	var request GetTeamGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx.Request().Context(), request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		return validResponse.VisitGetTeamGetResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+// GetTeamMergePolicy operation middleware
+func (sh *strictHandler) GetTeamMergePolicy(ctx echo.Context, params GetTeamMergePolicyParams) error {
+	var request GetTeamMergePolicyRequestObject
 
-type StrictHandlerFunc = strictecho.StrictEchoHandlerFunc
-type StrictMiddlewareFunc = strictecho.StrictEchoMiddlewareFunc
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamMergePolicy(ctx.Request().Context(), request.(GetTeamMergePolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamMergePolicy")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetTeamMergePolicyResponseObject); ok {
+		return validResponse.VisitGetTeamMergePolicyResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(ctx echo.Context) error {
-	var request PostPullRequestCreateRequestObject
+// PostTeamMergePolicy operation middleware
+func (sh *strictHandler) PostTeamMergePolicy(ctx echo.Context) error {
+	var request PostTeamMergePolicyRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostTeamMergePolicyJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx.Request().Context(), request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamMergePolicy(ctx.Request().Context(), request.(PostTeamMergePolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamMergePolicy")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		return validResponse.VisitPostPullRequestCreateResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTeamMergePolicyResponseObject); ok {
+		return validResponse.VisitPostTeamMergePolicyResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(ctx echo.Context) error {
-	var request PostPullRequestMergeRequestObject
+// PostTeamRemoveMember operation middleware
+func (sh *strictHandler) PostTeamRemoveMember(ctx echo.Context) error {
+	var request PostTeamRemoveMemberRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	var body PostTeamRemoveMemberJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx.Request().Context(), request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostTeamRemoveMember(ctx.Request().Context(), request.(PostTeamRemoveMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostTeamRemoveMember")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		return validResponse.VisitPostPullRequestMergeResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTeamRemoveMemberResponseObject); ok {
+		return validResponse.VisitPostTeamRemoveMemberResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(ctx echo.Context) error {
-	var request PostPullRequestReassignRequestObject
+// PostTeamRename operation middleware
+func (sh *strictHandler) PostTeamRename(ctx echo.Context) error {
+	var request PostTeamRenameRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostTeamRenameJSONRequestBody
 	if err := ctx.Bind(&body); err != nil {
 		return err
 	}
 	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx.Request().Context(), request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostTeamRename(ctx.Request().Context(), request.(PostTeamRenameRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostTeamRename")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		return validResponse.VisitPostPullRequestReassignResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTeamRenameResponseObject); ok {
+		return validResponse.VisitPostTeamRenameResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(ctx echo.Context) error {
-	var request PostTeamAddRequestObject
+// GetTeamSettings operation middleware
+func (sh *strictHandler) GetTeamSettings(ctx echo.Context, params GetTeamSettingsParams) error {
+	var request GetTeamSettingsRequestObject
 
-	var body PostTeamAddJSONRequestBody
-	if err := ctx.Bind(&body); err != nil {
-		return err
-	}
-	request.Body = &body
+	request.Params = params
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx.Request().Context(), request.(PostTeamAddRequestObject))
+		return sh.ssi.GetTeamSettings(ctx.Request().Context(), request.(GetTeamSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "GetTeamSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		return validResponse.VisitPostTeamAddResponse(ctx.Response())
+	} else if validResponse, ok := response.(GetTeamSettingsResponseObject); ok {
+		return validResponse.VisitGetTeamSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
 	return nil
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(ctx echo.Context, params GetTeamGetParams) error {
-	var request GetTeamGetRequestObject
+// PostTeamSettings operation middleware
+func (sh *strictHandler) PostTeamSettings(ctx echo.Context) error {
+	var request PostTeamSettingsRequestObject
 
-	request.Params = params
+	var body PostTeamSettingsJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
 
 	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx.Request().Context(), request.(GetTeamGetRequestObject))
+		return sh.ssi.PostTeamSettings(ctx.Request().Context(), request.(PostTeamSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostTeamSettings")
 	}
 
 	response, err := handler(ctx, request)
 
 	if err != nil {
 		return err
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		return validResponse.VisitGetTeamGetResponse(ctx.Response())
+	} else if validResponse, ok := response.(PostTeamSettingsResponseObject); ok {
+		return validResponse.VisitPostTeamSettingsResponse(ctx.Response())
 	} else if response != nil {
 		return fmt.Errorf("unexpected response type: %T", response)
 	}
@@ -711,6 +5218,35 @@ func (sh *strictHandler) GetUsersGetReview(ctx echo.Context, params GetUsersGetR
 	return nil
 }
 
+// PostUsersMoveTeam operation middleware
+func (sh *strictHandler) PostUsersMoveTeam(ctx echo.Context) error {
+	var request PostUsersMoveTeamRequestObject
+
+	var body PostUsersMoveTeamJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostUsersMoveTeam(ctx.Request().Context(), request.(PostUsersMoveTeamRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostUsersMoveTeam")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostUsersMoveTeamResponseObject); ok {
+		return validResponse.VisitPostUsersMoveTeamResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
 // PostUsersSetIsActive operation middleware
 func (sh *strictHandler) PostUsersSetIsActive(ctx echo.Context) error {
 	var request PostUsersSetIsActiveRequestObject
@@ -739,3 +5275,138 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx echo.Context) error {
 	}
 	return nil
 }
+
+// GetUsersTeamHistory operation middleware
+func (sh *strictHandler) GetUsersTeamHistory(ctx echo.Context, params GetUsersTeamHistoryParams) error {
+	var request GetUsersTeamHistoryRequestObject
+
+	request.Params = params
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersTeamHistory(ctx.Request().Context(), request.(GetUsersTeamHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersTeamHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetUsersTeamHistoryResponseObject); ok {
+		return validResponse.VisitGetUsersTeamHistoryResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// GetWebhooks operation middleware
+func (sh *strictHandler) GetWebhooks(ctx echo.Context) error {
+	var request GetWebhooksRequestObject
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetWebhooks(ctx.Request().Context(), request.(GetWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(GetWebhooksResponseObject); ok {
+		return validResponse.VisitGetWebhooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostWebhooks operation middleware
+func (sh *strictHandler) PostWebhooks(ctx echo.Context) error {
+	var request PostWebhooksRequestObject
+
+	var body PostWebhooksJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooks(ctx.Request().Context(), request.(PostWebhooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooks")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostWebhooksResponseObject); ok {
+		return validResponse.VisitPostWebhooksResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostWebhooksDelete operation middleware
+func (sh *strictHandler) PostWebhooksDelete(ctx echo.Context) error {
+	var request PostWebhooksDeleteRequestObject
+
+	var body PostWebhooksDeleteJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksDelete(ctx.Request().Context(), request.(PostWebhooksDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostWebhooksDeleteResponseObject); ok {
+		return validResponse.VisitPostWebhooksDeleteResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
+
+// PostWebhooksUpdate operation middleware
+func (sh *strictHandler) PostWebhooksUpdate(ctx echo.Context) error {
+	var request PostWebhooksUpdateRequestObject
+
+	var body PostWebhooksUpdateJSONRequestBody
+	if err := ctx.Bind(&body); err != nil {
+		return err
+	}
+	request.Body = &body
+
+	handler := func(ctx echo.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostWebhooksUpdate(ctx.Request().Context(), request.(PostWebhooksUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostWebhooksUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		return err
+	} else if validResponse, ok := response.(PostWebhooksUpdateResponseObject); ok {
+		return validResponse.VisitPostWebhooksUpdateResponse(ctx.Response())
+	} else if response != nil {
+		return fmt.Errorf("unexpected response type: %T", response)
+	}
+	return nil
+}
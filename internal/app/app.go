@@ -10,6 +10,7 @@ import (
 	"avito-test-applicant/internal/service"
 	"avito-test-applicant/pkg/httpserver"
 	"avito-test-applicant/pkg/postgres"
+	"context"
 	"fmt"
 	"net/http"
 	"os"
@@ -20,7 +21,6 @@ import (
 
 	"github.com/labstack/echo/v4"
 
-	gv "github.com/go-playground/validator/v10"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -45,17 +45,45 @@ func Run(cfg *config.Config) {
 
 	// Services dependencies
 	log.Info("Initializing services...")
+	strategy, err := service.ParseSelectionStrategy(cfg.Review.Strategy)
+	if err != nil {
+		log.Fatal(fmt.Errorf("app - Run - service.ParseSelectionStrategy: %w", err))
+	}
 	deps := service.ServicesDependencies{
-		Repos:     repositories,
-		TrManager: trManager,
+		Repos:             repositories,
+		TrManager:         trManager,
+		SelectionStrategy: strategy,
+		IdempotencyTTL:    cfg.Idempotency.TTL,
+		IdempotencyLease:  cfg.Idempotency.Lease,
+	}
+	if cfg.Auth.JWT.JWKSFile != "" || cfg.Auth.JWT.JWKSURL != "" {
+		log.Info("Loading JWKS...")
+		verifier, err := newJWTVerifier(cfg.Auth.JWT)
+		if err != nil {
+			log.Fatal(fmt.Errorf("app - Run - newJWTVerifier: %w", err))
+		}
+		deps.JWTVerifier = verifier
+	}
+	if cfg.Review.SeedFromPullRequest {
+		deps.RandSource = service.NewPullRequestRandSource(cfg.Review.Seed)
 	}
 	services := service.NewServices(deps)
 
+	if cfg.Auth.AdminToken != "" {
+		log.Info("Registering bootstrap admin token...")
+		if err := services.Auth.EnsureAdminToken(context.Background(), cfg.Auth.AdminToken); err != nil {
+			log.Fatal(fmt.Errorf("app - Run - services.Auth.EnsureAdminToken: %w", err))
+		}
+	}
+
 	// Echo
 	log.Info("Initializing handlers and routes...")
 	e := echo.New()
 	// setup handler validator as go-playground/validator
-	e.Validator = &requestValidator{v: gv.New()}
+	e.Validator = middleware.NewRequestValidator()
+
+	// request id goes first so every error body carries it
+	e.Use(middleware.RequestID())
 
 	// Swagger UI
 	staticFS := http.FS(avitotestapplicant.SwaggerFS)
@@ -69,18 +97,47 @@ func Run(cfg *config.Config) {
 		})
 	})
 
+	// bearer token -> principal; roles are checked per operation by Authorize
+	e.Use(middleware.Authenticate(services.Auth))
+
 	// HTTP error handler
 	e.HTTPErrorHandler = middleware.NewHTTPErrorHandler(log.StandardLogger())
 
 	// HTTP server
 	serverImpl := handlers.NewServer(services)
-	strictServer := apigen.NewStrictHandler(serverImpl, nil)
-	apigen.RegisterHandlers(e, strictServer)
+	// the last middleware runs first: authorize, then validate the request
+	strictServer := apigen.NewStrictHandler(serverImpl, []apigen.StrictMiddlewareFunc{
+		middleware.Validate(),
+		middleware.Authorize(),
+	})
+	// a POST repeated with the same Idempotency-Key gets the stored response
+	api := e.Group("", middleware.Idempotency(services.Idempotency))
+	apigen.RegisterHandlers(api, strictServer)
+
+	// Webhooks
+	log.Info("Starting webhook dispatcher...")
+	dispatcher := service.NewWebhookDispatcher(repositories, trManager, service.WebhookDispatcherConfig{
+		Timeout:     cfg.Webhooks.Timeout,
+		MaxAttempts: cfg.Webhooks.MaxAttempts,
+		BaseBackoff: cfg.Webhooks.BaseBackoff,
+		MaxBackoff:  cfg.Webhooks.MaxBackoff,
+		BatchSize:   cfg.Webhooks.BatchSize,
+	})
+	dispatcherCtx, stopDispatcher := context.WithCancel(context.Background())
+	dispatcherDone := runWebhookDispatcher(dispatcherCtx, dispatcher, cfg.Webhooks.PollInterval)
+
+	log.Info("Starting idempotency key cleanup...")
+	cleanupCtx, stopCleanup := context.WithCancel(context.Background())
+	cleanupDone := runIdempotencyCleanup(cleanupCtx, services.Idempotency, cfg.Idempotency.CleanupInterval)
 
 	// HTTP server wrapper
 	log.Info("Starting http server...")
 	log.Debugf("Server port: %s", cfg.HTTP.Port)
-	httpServer := httpserver.New(e, httpserver.Port(cfg.HTTP.Port))
+	httpServer := httpserver.New(e,
+		httpserver.Port(cfg.HTTP.Port),
+		// event streams never finish on their own
+		httpserver.OnShutdown(serverImpl.CloseStreams),
+	)
 
 	// Waiting signal
 	log.Info("Configuring graceful shutdown...")
@@ -100,11 +157,10 @@ func Run(cfg *config.Config) {
 	if err != nil {
 		log.Error(fmt.Errorf("app - Run - httpServer.Shutdown: %w", err))
 	}
-}
-
-// requestValidator adapts go-playground/validator to echo.Validator
-type requestValidator struct{ v *gv.Validate }
 
-func (cv *requestValidator) Validate(i interface{}) error {
-	return cv.v.Struct(i)
+	// undelivered events stay in the outbox for the next start
+	stopDispatcher()
+	<-dispatcherDone
+	stopCleanup()
+	<-cleanupDone
 }
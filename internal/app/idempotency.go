@@ -0,0 +1,53 @@
+package app
+
+import (
+	"avito-test-applicant/internal/service"
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultIdempotencyCleanupInterval = 10 * time.Minute
+
+// runIdempotencyCleanup deletes expired idempotency keys every interval until
+// ctx is done; the returned channel is closed once the loop has stopped
+func runIdempotencyCleanup(
+	ctx context.Context,
+	idempotency service.Idempotency,
+	interval time.Duration,
+) <-chan struct{} {
+	if interval <= 0 {
+		interval = defaultIdempotencyCleanupInterval
+	}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			n, err := idempotency.DeleteExpired(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					log.Error(fmt.Errorf("app - runIdempotencyCleanup - idempotency.DeleteExpired: %w", err))
+				}
+				continue
+			}
+			if n > 0 {
+				log.Debugf("Deleted %d expired idempotency keys", n)
+			}
+		}
+	}()
+
+	return done
+}
@@ -0,0 +1,40 @@
+package app
+
+import (
+	"avito-test-applicant/config"
+	"avito-test-applicant/pkg/jwt"
+	"context"
+	"time"
+)
+
+const jwksFetchTimeout = 10 * time.Second
+
+// newJWTVerifier loads the key set from the configured file or URL; the
+// URL is fetched once here so a broken JWKS fails the startup
+func newJWTVerifier(cfg config.JWT) (*jwt.Verifier, error) {
+	var keys jwt.KeySource
+	if cfg.JWKSFile != "" {
+		set, err := jwt.LoadKeySetFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = set
+	} else {
+		remote := jwt.NewRemoteKeySet(cfg.JWKSURL, nil)
+		ctx, cancel := context.WithTimeout(context.Background(), jwksFetchTimeout)
+		defer cancel()
+		if err := remote.Refresh(ctx); err != nil {
+			return nil, err
+		}
+		keys = remote
+	}
+
+	var opts []jwt.Option
+	if cfg.Issuer != "" {
+		opts = append(opts, jwt.Issuer(cfg.Issuer))
+	}
+	if cfg.Audience != "" {
+		opts = append(opts, jwt.Audience(cfg.Audience))
+	}
+	return jwt.NewVerifier(keys, opts...), nil
+}
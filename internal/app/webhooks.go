@@ -0,0 +1,56 @@
+package app
+
+import (
+	"avito-test-applicant/internal/service"
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const defaultWebhookPollInterval = time.Second
+
+// runWebhookDispatcher sends due webhook deliveries every interval until
+// ctx is done; the returned channel is closed once the loop has stopped
+func runWebhookDispatcher(
+	ctx context.Context,
+	dispatcher *service.WebhookDispatcher,
+	interval time.Duration,
+) <-chan struct{} {
+	if interval <= 0 {
+		interval = defaultWebhookPollInterval
+	}
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			// drain everything that is due before waiting again
+			for ctx.Err() == nil {
+				n, err := dispatcher.DispatchPending(ctx)
+				if err != nil {
+					if ctx.Err() == nil {
+						log.Error(fmt.Errorf("app - runWebhookDispatcher - dispatcher.DispatchPending: %w", err))
+					}
+					break
+				}
+				if n == 0 {
+					break
+				}
+			}
+		}
+	}()
+
+	return done
+}
@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	RoleAdmin    Role = "admin"
+	RoleTeamLead Role = "team-lead"
+	RoleUser     Role = "user"
+)
+
+type Role string
+
+// rolePriority higher wins when a JWT carries several roles
+var rolePriority = map[Role]int{
+	RoleUser:     1,
+	RoleTeamLead: 2,
+	RoleAdmin:    3,
+}
+
+// HighestRole picks the most privileged known role, false if none is known
+func HighestRole(roles []string) (Role, bool) {
+	var best Role
+	for _, r := range roles {
+		role := Role(r)
+		if rolePriority[role] > rolePriority[best] {
+			best = role
+		}
+	}
+	return best, best != ""
+}
+
+// APIToken issued bearer token; only its hash is stored
+type APIToken struct {
+	TokenId uuid.UUID `json:"token_id"`
+	Role    Role      `json:"role"`
+	// UserId owner of the token, optional for admins
+	UserId    *uuid.UUID `json:"user_id,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// Principal authenticated caller; TeamId is set when the token has an owner.
+// TokenId is set for API tokens, Subject for JWTs
+type Principal struct {
+	TokenId uuid.UUID
+	Subject string
+	Role    Role
+	UserId  *uuid.UUID
+	TeamId  *uuid.UUID
+}
+
+type principalKey struct{}
+
+type actorKey struct{}
+
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func PrincipalFromContext(ctx context.Context) (Principal, bool) {
+	p, ok := ctx.Value(principalKey{}).(Principal)
+	return p, ok
+}
+
+// WithActor stores the id of the user on whose behalf the request is served
+func WithActor(ctx context.Context, userId uuid.UUID) context.Context {
+	return context.WithValue(ctx, actorKey{}, userId)
+}
+
+func ActorFromContext(ctx context.Context) *uuid.UUID {
+	if id, ok := ctx.Value(actorKey{}).(uuid.UUID); ok {
+		return &id
+	}
+	return nil
+}
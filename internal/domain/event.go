@@ -0,0 +1,97 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EventPullRequestCreated EventType = "pull_request.created"
+	// EventPullRequestStatusChanged close, reopen or ready for review;
+	// a merge is reported as EventPullRequestMerged
+	EventPullRequestStatusChanged EventType = "pull_request.status_changed"
+	EventPullRequestMerged        EventType = "pull_request.merged"
+	EventReviewerAssigned         EventType = "reviewer.assigned"
+	EventReviewerUnassigned       EventType = "reviewer.unassigned"
+	EventReviewSubmitted          EventType = "review.submitted"
+	EventUserActivated            EventType = "user.activated"
+	EventUserDeactivated          EventType = "user.deactivated"
+)
+
+// DefaultWebhookEventTypes what a webhook without an explicit subscription
+// receives: the types that existed when webhooks were introduced, so such
+// receivers never get a type they were not written for
+var DefaultWebhookEventTypes = []EventType{
+	EventPullRequestCreated,
+	EventPullRequestMerged,
+	EventReviewerAssigned,
+	EventReviewerUnassigned,
+}
+
+// EventTypes every event type, webhooks can subscribe to any of them
+var EventTypes = []EventType{
+	EventPullRequestCreated,
+	EventPullRequestStatusChanged,
+	EventPullRequestMerged,
+	EventReviewerAssigned,
+	EventReviewerUnassigned,
+	EventReviewSubmitted,
+	EventUserActivated,
+	EventUserDeactivated,
+}
+
+// EventVersion version of the event document schema. Within a version
+// fields are only added, never renamed or removed
+const EventVersion = 1
+
+type EventType string
+
+// Event JSON document describing one change; the same document is stored
+// in the event feed and sent to webhooks. Consumers dedupe by Id
+type Event struct {
+	Id         uuid.UUID `json:"id"`
+	Type       EventType `json:"type"`
+	Version    int       `json:"version"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       EventData `json:"data"`
+}
+
+// EventData fields set depend on the event type: PR name, author and status
+// for pull_request.created, Status and PreviousStatus for status changes,
+// UserId for reviewer, review and user events, ReviewState for reviews
+type EventData struct {
+	PullRequestId   *uuid.UUID         `json:"pull_request_id,omitempty"`
+	PullRequestName string             `json:"pull_request_name,omitempty"`
+	AuthorId        *uuid.UUID         `json:"author_id,omitempty"`
+	Status          *PullRequestStatus `json:"status,omitempty"`
+	PreviousStatus  *PullRequestStatus `json:"previous_status,omitempty"`
+	UserId          *uuid.UUID         `json:"user_id,omitempty"`
+	ReviewState     *ReviewState       `json:"review_state,omitempty"`
+	// ActorId user who made the change, nil when unknown
+	ActorId *uuid.UUID `json:"actor_id,omitempty"`
+	Reason  string     `json:"reason,omitempty"`
+}
+
+// EventCursor position in the event feed. Positions are given out after
+// the writing transaction commits, in commit order, so a reader never skips
+// an event that commits later
+type EventCursor struct {
+	Position int64
+}
+
+// EventRecord event together with its position in the feed
+type EventRecord struct {
+	Cursor EventCursor
+	Event  Event
+}
+
+// EventPage events after a cursor. Unlike other pages the feed never ends:
+// NextCursor is where to continue from, the requested cursor when there
+// are no new events, and nil only while the feed is empty
+type EventPage struct {
+	Events     []EventRecord
+	NextCursor *EventCursor
+	// HasMore is set when more events are available right away
+	HasMore bool
+}
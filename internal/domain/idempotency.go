@@ -0,0 +1,20 @@
+package domain
+
+import "time"
+
+// IdempotencyRecord response stored under an Idempotency-Key. Scope keeps
+// keys of different callers apart, RequestHash detects a key reused for
+// another request
+type IdempotencyRecord struct {
+	Scope       string
+	Key         string
+	RequestHash []byte
+	// StatusCode nil while the first request with the key is still served
+	StatusCode *int
+	Body       []byte
+	CreatedAt  time.Time
+	ExpiresAt  time.Time
+	// LockedUntil end of the reservation of a request still being served;
+	// after it the key may be reserved again
+	LockedUntil *time.Time
+}
@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MembershipEvent one append-only entry of a user's team history: a move
+// between teams or a removal from the team
+type MembershipEvent struct {
+	Id     int64     `json:"id"`
+	UserId uuid.UUID `json:"user_id"`
+	// FromTeamId team the user left, nil when they had none
+	FromTeamId *uuid.UUID `json:"from_team_id,omitempty"`
+	// ToTeamId team the user joined, nil when they were removed
+	ToTeamId *uuid.UUID `json:"to_team_id,omitempty"`
+	// ActorId user who made the change, nil when unknown
+	ActorId   *uuid.UUID `json:"actor_id,omitempty"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"created_at"`
+}
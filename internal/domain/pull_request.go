@@ -7,12 +7,31 @@ import (
 )
 
 const (
-	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
 )
 
 type PullRequestStatus string
 
+const (
+	ReviewStatePending          ReviewState = "PENDING"
+	ReviewStateApproved         ReviewState = "APPROVED"
+	ReviewStateChangesRequested ReviewState = "CHANGES_REQUESTED"
+)
+
+type ReviewState string
+
+// Review state of one reviewer on a PR; ReviewedAt is set by the last verdict
+type Review struct {
+	PullRequestId uuid.UUID   `json:"pull_request_id"`
+	UserId        uuid.UUID   `json:"user_id"`
+	State         ReviewState `json:"state"`
+	AssignedAt    time.Time   `json:"assigned_at"`
+	ReviewedAt    *time.Time  `json:"reviewed_at"`
+}
+
 type PullRequest struct {
 	PullRequestId   uuid.UUID         `json:"pull_request_id"`
 	AuthorId        uuid.UUID         `json:"author_id"`
@@ -31,6 +50,20 @@ type PullRequestReviewers struct {
 type PullRequestWithReviewers struct {
 	PullRequest
 	Reviewers []uuid.UUID `json:"reviewers"`
+	// FallbackReviewers reviewers taken from fallback teams of the author's team
+	FallbackReviewers []uuid.UUID `json:"fallback_reviewers,omitempty"`
+	Reviews           []Review    `json:"reviews,omitempty"`
+}
+
+type PullRequestReassignment struct {
+	PullRequestWithReviewers
+	ReplacedBy uuid.UUID `json:"replaced_by"`
+}
+
+// ReviewAssignment one row of pr_reviewers
+type ReviewAssignment struct {
+	PullRequestId uuid.UUID `json:"pull_request_id"`
+	UserId        uuid.UUID `json:"user_id"`
 }
 
 type PullRequestShort struct {
@@ -38,4 +71,43 @@ type PullRequestShort struct {
 	AuthorId        uuid.UUID         `json:"author_id"`
 	PullRequestName string            `json:"pull_request_name"`
 	Status          PullRequestStatus `json:"status"`
+	// ReviewState is set only when listing reviews of a user
+	ReviewState *ReviewState `json:"review_state,omitempty"`
+}
+
+const (
+	DefaultPageLimit = 20
+	MaxPageLimit     = 100
+)
+
+const (
+	SortOrderAsc  SortOrder = "asc"
+	SortOrderDesc SortOrder = "desc"
+)
+
+type SortOrder string
+
+// PullRequestCursor position in the (created_at, id) keyset
+type PullRequestCursor struct {
+	CreatedAt     time.Time
+	PullRequestId uuid.UUID
+}
+
+// PullRequestFilter nil fields are not applied
+type PullRequestFilter struct {
+	Status        *PullRequestStatus
+	AuthorId      *uuid.UUID
+	TeamId        *uuid.UUID
+	ReviewerId    *uuid.UUID
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+	Order         SortOrder
+	After         *PullRequestCursor
+	Limit         int
+}
+
+type PullRequestPage struct {
+	PullRequests []PullRequestShort
+	// NextCursor is nil on the last page
+	NextCursor *PullRequestCursor
 }
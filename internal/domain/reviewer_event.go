@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ReviewerEventAssigned   ReviewerEventType = "ASSIGNED"
+	ReviewerEventUnassigned ReviewerEventType = "UNASSIGNED"
+	ReviewerEventReassigned ReviewerEventType = "REASSIGNED"
+	ReviewerEventMerged     ReviewerEventType = "MERGED"
+	// ReviewerEventDeactivated review handed over because the reviewer was deactivated
+	ReviewerEventDeactivated ReviewerEventType = "DEACTIVATED"
+)
+
+type ReviewerEventType string
+
+// ReviewerEvent one append-only entry of a PR's assignment history
+type ReviewerEvent struct {
+	Id            int64             `json:"id"`
+	PullRequestId uuid.UUID         `json:"pull_request_id"`
+	Type          ReviewerEventType `json:"type"`
+	// UserId reviewer the event is about, nil for MERGED
+	UserId *uuid.UUID `json:"user_id,omitempty"`
+	// ReplacedBy new reviewer of REASSIGNED and DEACTIVATED events
+	ReplacedBy *uuid.UUID `json:"replaced_by,omitempty"`
+	// ActorId user who made the change, nil when unknown
+	ActorId   *uuid.UUID `json:"actor_id,omitempty"`
+	Reason    string     `json:"reason"`
+	CreatedAt time.Time  `json:"created_at"`
+}
@@ -0,0 +1,27 @@
+package domain
+
+import "github.com/google/uuid"
+
+type ReviewerStats struct {
+	UserId           uuid.UUID `json:"user_id"`
+	Username         string    `json:"username"`
+	TotalAssignments int       `json:"total_assignments"`
+	OpenReviews      int       `json:"open_reviews"`
+	MergedReviews    int       `json:"merged_reviews"`
+}
+
+type AuthorStats struct {
+	UserId       uuid.UUID `json:"user_id"`
+	Username     string    `json:"username"`
+	PullRequests int       `json:"pull_requests"`
+}
+
+// Stats review load distribution; for a single team PRs are counted by the
+// author's team
+type Stats struct {
+	Reviewers                    []ReviewerStats `json:"reviewers"`
+	Authors                      []AuthorStats   `json:"authors"`
+	TotalPullRequests            int             `json:"total_pull_requests"`
+	AvgReviewersPerPullRequest   float64         `json:"avg_reviewers_per_pull_request"`
+	PullRequestsWithoutReviewers int             `json:"pull_requests_without_reviewers"`
+}
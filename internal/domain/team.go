@@ -2,12 +2,75 @@ package domain
 
 import "github.com/google/uuid"
 
+// DefaultReviewersRequired is used for teams that did not specify their own value
+const DefaultReviewersRequired = 2
+
 type Team struct {
-	TeamId   uuid.UUID `json:"team_id"`
-	TeamName string    `json:"team_name"`
+	TeamId            uuid.UUID `json:"team_id"`
+	TeamName          string    `json:"team_name"`
+	ReviewersRequired int       `json:"reviewers_required"`
+}
+
+// TeamFallbacks fallback teams ordered by priority
+type TeamFallbacks struct {
+	Team          Team   `json:"team"`
+	FallbackTeams []Team `json:"fallback_teams"`
 }
 
 type TeamWithUsers struct {
 	Team  Team   `json:"team"`
 	Users []User `json:"users,omitempty"`
 }
+
+// TeamCreation result of creating a team; Moved lists existing users that
+// were taken from their previous teams on request
+type TeamCreation struct {
+	TeamWithUsers
+	Moved []MembershipChange `json:"moved"`
+}
+
+const (
+	SelectionStrategyRandom      SelectionStrategy = "random"
+	SelectionStrategyLeastLoaded SelectionStrategy = "least_loaded"
+	SelectionStrategyRoundRobin  SelectionStrategy = "round_robin"
+	SelectionStrategyWeighted    SelectionStrategy = "weighted"
+)
+
+type SelectionStrategy string
+
+type TeamSettings struct {
+	TeamId            uuid.UUID         `json:"team_id"`
+	SelectionStrategy SelectionStrategy `json:"selection_strategy"`
+	// ReviewersRequired is kept on the team itself, not in team_settings
+	ReviewersRequired int `json:"reviewers_required"`
+}
+
+// ReviewHandover open review of a user who was deactivated or left the team;
+// ReplacedBy is nil when nobody in the team could take it over
+type ReviewHandover struct {
+	PullRequestId uuid.UUID  `json:"pull_request_id"`
+	UserId        uuid.UUID  `json:"user_id"`
+	ReplacedBy    *uuid.UUID `json:"replaced_by,omitempty"`
+}
+
+type TeamDeactivation struct {
+	TeamName    string           `json:"team_name"`
+	Deactivated []uuid.UUID      `json:"deactivated"`
+	Reviews     []ReviewHandover `json:"reviews"`
+}
+
+// MembershipChange result of moving a user to another team or removing them
+// from their team; Reviews are their open reviews handed over within the old team
+type MembershipChange struct {
+	User    UserWithTeamName `json:"user"`
+	Reviews []ReviewHandover `json:"reviews"`
+}
+
+// MergePolicy rules checked before a PR of the team can be merged;
+// zero value means no gating
+type MergePolicy struct {
+	TeamId              uuid.UUID  `json:"team_id"`
+	RequiredApprovals   int        `json:"required_approvals"`
+	RequireLeadApproval bool       `json:"require_lead_approval"`
+	LeadUserId          *uuid.UUID `json:"lead_user_id,omitempty"`
+}
@@ -21,3 +21,16 @@ type UserWithTeamName struct {
 	UserId   uuid.UUID `json:"user_id"`
 	Username string    `json:"username"`
 }
+
+type ReviewReassignment struct {
+	PullRequestId uuid.UUID `json:"pull_request_id"`
+	ReplacedBy    uuid.UUID `json:"replaced_by"`
+}
+
+// UserActivityChange result of toggling is_active; on deactivation open reviews
+// are handed over to other reviewers where possible
+type UserActivityChange struct {
+	User          UserWithTeamName     `json:"user"`
+	Reassigned    []ReviewReassignment `json:"reassigned"`
+	NotReassigned []uuid.UUID          `json:"not_reassigned"`
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "PENDING"
+	WebhookDeliveryDelivered WebhookDeliveryStatus = "DELIVERED"
+	// WebhookDeliveryFailed all attempts are used up, the delivery is not retried
+	WebhookDeliveryFailed WebhookDeliveryStatus = "FAILED"
+)
+
+type WebhookDeliveryStatus string
+
+// Webhook registered receiver of events; the signing secret is not part
+// of it and is known only on creation
+type Webhook struct {
+	WebhookId uuid.UUID `json:"webhook_id"`
+	URL       string    `json:"url"`
+	// EventTypes subscribed event types, empty for DefaultWebhookEventTypes
+	EventTypes []EventType `json:"event_types"`
+	IsActive   bool        `json:"is_active"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// Subscribed reports whether the webhook receives events of the type
+func (w Webhook) Subscribed(eventType EventType) bool {
+	eventTypes := w.EventTypes
+	if len(eventTypes) == 0 {
+		eventTypes = DefaultWebhookEventTypes
+	}
+	for _, t := range eventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookUpdate fields of a webhook to change, nil ones are kept
+type WebhookUpdate struct {
+	URL        *string
+	EventTypes *[]EventType
+	IsActive   *bool
+}
+
+// WebhookDelivery one event queued for one webhook
+type WebhookDelivery struct {
+	Id            int64
+	WebhookId     uuid.UUID
+	EventId       uuid.UUID
+	EventType     EventType
+	Payload       []byte
+	Status        WebhookDeliveryStatus
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	CreatedAt     time.Time
+	DeliveredAt   *time.Time
+}
+
+// WebhookDispatch delivery claimed by the dispatcher together with where
+// to send it and how to sign it
+type WebhookDispatch struct {
+	Delivery WebhookDelivery
+	URL      string
+	Secret   string
+}
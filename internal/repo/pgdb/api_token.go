@@ -0,0 +1,127 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type APITokenRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewAPITokenRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *APITokenRepo {
+	return &APITokenRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// CreateToken stores the token under its hash; ErrAlreadyExists when the
+// hash is already known
+func (r *APITokenRepo) CreateToken(
+	ctx context.Context,
+	token domain.APIToken,
+	tokenHash []byte,
+) (domain.APIToken, error) {
+	sql, args, err := r.Builder.
+		Insert("api_tokens").
+		Columns("id", "token_hash", "role", "user_id", "created_at").
+		Values(token.TokenId, tokenHash, string(token.Role), token.UserId, time.Now().UTC()).
+		Suffix("RETURNING id, role, user_id, created_at, revoked_at").
+		ToSql()
+	if err != nil {
+		return domain.APIToken{}, fmt.Errorf("build insert api token sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	created, err := scanAPIToken(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.APIToken{}, repoerrors.ErrAlreadyExists
+		}
+		return domain.APIToken{}, fmt.Errorf("exec insert api token: %w", err)
+	}
+
+	return created, nil
+}
+
+// GetByHash returns the token including revoked ones
+func (r *APITokenRepo) GetByHash(
+	ctx context.Context,
+	tokenHash []byte,
+) (domain.APIToken, error) {
+	sql, args, err := r.Builder.
+		Select("id", "role", "user_id", "created_at", "revoked_at").
+		From("api_tokens").
+		Where(squirrel.Eq{"token_hash": tokenHash}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return domain.APIToken{}, fmt.Errorf("build select api token sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	token, err := scanAPIToken(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.APIToken{}, repoerrors.ErrNotFound
+		}
+		return domain.APIToken{}, fmt.Errorf("query api token: %w", err)
+	}
+
+	return token, nil
+}
+
+// RevokeToken marks an active token revoked; ErrNotFound if there is none
+func (r *APITokenRepo) RevokeToken(
+	ctx context.Context,
+	tokenId uuid.UUID,
+) (domain.APIToken, error) {
+	sql, args, err := r.Builder.
+		Update("api_tokens").
+		Set("revoked_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": tokenId}).
+		Where(squirrel.Eq{"revoked_at": nil}).
+		Suffix("RETURNING id, role, user_id, created_at, revoked_at").
+		ToSql()
+	if err != nil {
+		return domain.APIToken{}, fmt.Errorf("build revoke api token sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	token, err := scanAPIToken(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.APIToken{}, repoerrors.ErrNotFound
+		}
+		return domain.APIToken{}, fmt.Errorf("exec revoke api token: %w", err)
+	}
+
+	return token, nil
+}
+
+func scanAPIToken(row pgx.Row) (domain.APIToken, error) {
+	var t domain.APIToken
+	var role string
+	if err := row.Scan(&t.TokenId, &role, &t.UserId, &t.CreatedAt, &t.RevokedAt); err != nil {
+		return domain.APIToken{}, err
+	}
+	t.Role = domain.Role(role)
+	return t, nil
+}
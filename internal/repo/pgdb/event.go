@@ -0,0 +1,186 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+)
+
+// eventSequencerLock advisory lock key that lets one sequencer run at a time
+const eventSequencerLock = 7254301
+
+type EventRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewEventRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *EventRepo {
+	return &EventRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// Append inserts events in one statement; the events must be stamped already
+func (r *EventRepo) Append(
+	ctx context.Context,
+	events ...domain.Event,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	builder := r.Builder.
+		Insert("events").
+		Columns("event_id", "event_type", "version", "payload", "created_at")
+	for _, e := range events {
+		payload, err := json.Marshal(e)
+		if err != nil {
+			return fmt.Errorf("marshal event: %w", err)
+		}
+		builder = builder.Values(e.Id, string(e.Type), e.Version, payload, e.OccurredAt)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec insert events: %w", err)
+	}
+	return nil
+}
+
+// Sequence gives committed events without a position the next positions in
+// id order and returns how many it numbered, none when another sequencer
+// is running. It must run in its own short transaction: positions are handed
+// out only after the writing transaction has committed and one sequencer
+// commit at a time, so a reader that has seen a position never gets a
+// smaller one later. A long transaction elsewhere delays nothing but its
+// own events
+func (r *EventRepo) Sequence(
+	ctx context.Context,
+) (int64, error) {
+	lockSql, lockArgs, err := r.Builder.
+		Select().
+		Column(squirrel.Expr("pg_try_advisory_xact_lock(?)", eventSequencerLock)).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build lock event sequencer sql: %w", err)
+	}
+
+	pending := r.Builder.
+		Select("id", "(SELECT COALESCE(MAX(position), 0) FROM events) + ROW_NUMBER() OVER (ORDER BY id) AS position").
+		From("events").
+		Where(squirrel.Eq{"position": nil})
+	sql, args, err := r.Builder.
+		Update("events e").
+		Set("position", squirrel.Expr("pending.position")).
+		FromSelect(pending, "pending").
+		Where("e.id = pending.id").
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build sequence events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var locked bool
+	if err := conn.QueryRow(ctx, lockSql, lockArgs...).Scan(&locked); err != nil {
+		return 0, fmt.Errorf("lock event sequencer: %w", err)
+	}
+	if !locked {
+		return 0, nil
+	}
+
+	// a statement after the lock sees every earlier sequencer's commit
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec sequence events: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
+
+// List returns up to limit sequenced events after the cursor (from the
+// start when it is nil)
+func (r *EventRepo) List(
+	ctx context.Context,
+	after *domain.EventCursor,
+	limit int,
+) ([]domain.EventRecord, error) {
+	builder := r.Builder.
+		Select("position", "payload").
+		From("events").
+		Where(squirrel.NotEq{"position": nil}).
+		OrderBy("position").
+		Limit(uint64(limit))
+	if after != nil {
+		builder = builder.Where(squirrel.Gt{"position": after.Position})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query events: %w", err)
+	}
+	defer rows.Close()
+
+	records := make([]domain.EventRecord, 0)
+	for rows.Next() {
+		var rec domain.EventRecord
+		if err := rows.Scan(&rec.Cursor.Position, &rec.Event); err != nil {
+			return nil, fmt.Errorf("scan event row: %w", err)
+		}
+		records = append(records, rec)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate event rows: %w", err)
+	}
+
+	return records, nil
+}
+
+// LastCursor returns the position of the last visible event, nil while
+// there is none
+func (r *EventRepo) LastCursor(
+	ctx context.Context,
+) (*domain.EventCursor, error) {
+	sql, args, err := r.Builder.
+		Select("position").
+		From("events").
+		Where(squirrel.NotEq{"position": nil}).
+		OrderBy("position DESC").
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select last event sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var cursor domain.EventCursor
+	err = conn.QueryRow(ctx, sql, args...).Scan(&cursor.Position)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("query last event: %w", err)
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,168 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/jackc/pgx/v5"
+)
+
+type IdempotencyKeyRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewIdempotencyKeyRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *IdempotencyKeyRepo {
+	return &IdempotencyKeyRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// Reserve inserts a record without a response; false when the key is
+// already taken in the scope. An expired record or a reservation whose lock
+// ran out is taken over as if the key were free
+func (r *IdempotencyKeyRepo) Reserve(
+	ctx context.Context,
+	record domain.IdempotencyRecord,
+) (bool, error) {
+	sql, args, err := r.Builder.
+		Insert("idempotency_keys").
+		Columns("scope", "idempotency_key", "request_hash", "created_at", "expires_at", "locked_until").
+		Values(record.Scope, record.Key, record.RequestHash, record.CreatedAt, record.ExpiresAt, record.LockedUntil).
+		Suffix("ON CONFLICT (scope, idempotency_key) DO UPDATE SET " +
+			"request_hash = EXCLUDED.request_hash, " +
+			"status_code = NULL, " +
+			"body = NULL, " +
+			"created_at = EXCLUDED.created_at, " +
+			"expires_at = EXCLUDED.expires_at, " +
+			"locked_until = EXCLUDED.locked_until " +
+			"WHERE idempotency_keys.expires_at <= EXCLUDED.created_at " +
+			"OR idempotency_keys.locked_until <= EXCLUDED.created_at").
+		ToSql()
+	if err != nil {
+		return false, fmt.Errorf("build insert idempotency key sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return false, fmt.Errorf("exec insert idempotency key: %w", err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *IdempotencyKeyRepo) Get(
+	ctx context.Context,
+	scope string,
+	key string,
+) (domain.IdempotencyRecord, error) {
+	sql, args, err := r.Builder.
+		Select("scope", "idempotency_key", "request_hash", "status_code", "body", "created_at", "expires_at", "locked_until").
+		From("idempotency_keys").
+		Where(squirrel.Eq{"scope": scope, "idempotency_key": key}).
+		ToSql()
+	if err != nil {
+		return domain.IdempotencyRecord{}, fmt.Errorf("build select idempotency key sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var rec domain.IdempotencyRecord
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&rec.Scope,
+		&rec.Key,
+		&rec.RequestHash,
+		&rec.StatusCode,
+		&rec.Body,
+		&rec.CreatedAt,
+		&rec.ExpiresAt,
+		&rec.LockedUntil,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.IdempotencyRecord{}, repoerrors.ErrNotFound
+		}
+		return domain.IdempotencyRecord{}, fmt.Errorf("query idempotency key: %w", err)
+	}
+
+	return rec, nil
+}
+
+// Complete stores the response of the request that reserved the key
+func (r *IdempotencyKeyRepo) Complete(
+	ctx context.Context,
+	scope string,
+	key string,
+	statusCode int,
+	body []byte,
+) error {
+	sql, args, err := r.Builder.
+		Update("idempotency_keys").
+		Set("status_code", statusCode).
+		Set("body", body).
+		Set("locked_until", nil).
+		Where(squirrel.Eq{"scope": scope, "idempotency_key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update idempotency key sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec update idempotency key: %w", err)
+	}
+	return nil
+}
+
+func (r *IdempotencyKeyRepo) Delete(
+	ctx context.Context,
+	scope string,
+	key string,
+) error {
+	sql, args, err := r.Builder.
+		Delete("idempotency_keys").
+		Where(squirrel.Eq{"scope": scope, "idempotency_key": key}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete idempotency key sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec delete idempotency key: %w", err)
+	}
+	return nil
+}
+
+// DeleteExpired removes records whose ttl ran out before now
+func (r *IdempotencyKeyRepo) DeleteExpired(
+	ctx context.Context,
+	now time.Time,
+) (int64, error) {
+	sql, args, err := r.Builder.
+		Delete("idempotency_keys").
+		Where(squirrel.LtOrEq{"expires_at": now}).
+		ToSql()
+	if err != nil {
+		return 0, fmt.Errorf("build delete expired idempotency keys sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	tag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return 0, fmt.Errorf("exec delete expired idempotency keys: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
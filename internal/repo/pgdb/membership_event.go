@@ -0,0 +1,101 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+)
+
+type MembershipEventRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewMembershipEventRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *MembershipEventRepo {
+	return &MembershipEventRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// Append inserts events in one statement; Id and CreatedAt are ignored
+func (r *MembershipEventRepo) Append(
+	ctx context.Context,
+	events ...domain.MembershipEvent,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	builder := r.Builder.
+		Insert("membership_events").
+		Columns("user_id", "from_team_id", "to_team_id", "actor_id", "reason", "created_at")
+	for _, e := range events {
+		builder = builder.Values(e.UserId, e.FromTeamId, e.ToTeamId, e.ActorId, e.Reason, now)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert membership events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec insert membership events: %w", err)
+	}
+	return nil
+}
+
+// ListByUserId returns the user's events in the order they were written
+func (r *MembershipEventRepo) ListByUserId(
+	ctx context.Context,
+	userId uuid.UUID,
+) ([]domain.MembershipEvent, error) {
+	sql, args, err := r.Builder.
+		Select("id", "user_id", "from_team_id", "to_team_id", "actor_id", "reason", "created_at").
+		From("membership_events").
+		Where(squirrel.Eq{"user_id": userId}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select membership events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query membership events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.MembershipEvent, 0)
+	for rows.Next() {
+		var e domain.MembershipEvent
+		if err := rows.Scan(
+			&e.Id,
+			&e.UserId,
+			&e.FromTeamId,
+			&e.ToTeamId,
+			&e.ActorId,
+			&e.Reason,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan membership event row: %w", err)
+		}
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
@@ -28,23 +28,17 @@ func NewPullRequestRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *PullRe
 	}
 }
 
-func toDomainPullRequestStatus(smallint int) domain.PullRequestStatus {
-	if smallint == 1 {
-		return domain.PullRequestStatusMERGED
-	}
-	return domain.PullRequestStatusOPEN
-}
-
 func (r *PullRequestRepo) CreatePullRequest(
 	ctx context.Context,
 	pullRequestId uuid.UUID,
 	pullRequestName string,
 	authorId uuid.UUID,
+	status domain.PullRequestStatus,
 ) (domain.PullRequest, error) {
 	sql, args, err := r.Builder.
 		Insert("pull_requests").
 		Columns("id", "pr_name", "author_id", "pr_status", "created_at").
-		Values(pullRequestId, pullRequestName, authorId, 0, time.Now().UTC()).
+		Values(pullRequestId, pullRequestName, authorId, string(status), time.Now().UTC()).
 		Suffix("RETURNING id, pr_name, author_id, pr_status, created_at, merged_at").
 		ToSql()
 	if err != nil {
@@ -54,12 +48,12 @@ func (r *PullRequestRepo) CreatePullRequest(
 	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
 
 	var pr domain.PullRequest
-	var statusSmallint int
+	var statusText string
 	err = conn.QueryRow(ctx, sql, args...).Scan(
 		&pr.PullRequestId,
 		&pr.PullRequestName,
 		&pr.AuthorId,
-		&statusSmallint,
+		&statusText,
 		&pr.CreatedAt,
 		&pr.MergedAt,
 	)
@@ -71,7 +65,7 @@ func (r *PullRequestRepo) CreatePullRequest(
 
 	}
 
-	pr.Status = toDomainPullRequestStatus(statusSmallint)
+	pr.Status = domain.PullRequestStatus(statusText)
 
 	return pr, nil
 }
@@ -93,12 +87,12 @@ func (r *PullRequestRepo) GetPullRequestById(
 	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
 
 	var pr domain.PullRequest
-	var statusSmallint int
+	var statusText string
 	err = conn.QueryRow(ctx, sql, args...).Scan(
 		&pr.PullRequestId,
 		&pr.PullRequestName,
 		&pr.AuthorId,
-		&statusSmallint,
+		&statusText,
 		&pr.CreatedAt,
 		&pr.MergedAt,
 	)
@@ -109,7 +103,7 @@ func (r *PullRequestRepo) GetPullRequestById(
 		return domain.PullRequest{}, fmt.Errorf("query PR by id: %w", err)
 	}
 
-	pr.Status = toDomainPullRequestStatus(statusSmallint)
+	pr.Status = domain.PullRequestStatus(statusText)
 
 	return pr, nil
 }
@@ -143,18 +137,18 @@ func (r *PullRequestRepo) GetPullRequestsByIds(
 	found := make(map[uuid.UUID]domain.PullRequest, len(ids))
 	for rows.Next() {
 		var pr domain.PullRequest
-		var statusSmallint int
+		var statusText string
 		if err := rows.Scan(
 			&pr.PullRequestId,
 			&pr.PullRequestName,
 			&pr.AuthorId,
-			&statusSmallint,
+			&statusText,
 			&pr.CreatedAt,
 			&pr.MergedAt,
 		); err != nil {
 			return nil, fmt.Errorf("scan pr row: %w", err)
 		}
-		pr.Status = toDomainPullRequestStatus(statusSmallint)
+		pr.Status = domain.PullRequestStatus(statusText)
 		found[pr.PullRequestId] = pr
 	}
 	if err := rows.Err(); err != nil {
@@ -172,15 +166,17 @@ func (r *PullRequestRepo) GetPullRequestsByIds(
 	return out, nil
 }
 
+// SetMerged merges an OPEN PR; ErrNotFound when there is no OPEN PR with the id
 func (r *PullRequestRepo) SetMerged(
 	ctx context.Context,
 	pullRequestId uuid.UUID,
 ) (domain.PullRequest, error) {
 	sql, args, err := r.Builder.
 		Update("pull_requests").
-		Set("pr_status", 1).
+		Set("pr_status", string(domain.PullRequestStatusMERGED)).
 		Set("merged_at", time.Now()).
 		Where(squirrel.Eq{"id": pullRequestId}).
+		Where(squirrel.Eq{"pr_status": string(domain.PullRequestStatusOPEN)}).
 		Suffix("RETURNING id, pr_name, author_id, pr_status, created_at, merged_at").
 		ToSql()
 	if err != nil {
@@ -190,12 +186,12 @@ func (r *PullRequestRepo) SetMerged(
 	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
 
 	var pr domain.PullRequest
-	var statusSmallint int
+	var statusText string
 	err = conn.QueryRow(ctx, sql, args...).Scan(
 		&pr.PullRequestId,
 		&pr.PullRequestName,
 		&pr.AuthorId,
-		&statusSmallint,
+		&statusText,
 		&pr.CreatedAt,
 		&pr.MergedAt,
 	)
@@ -206,7 +202,140 @@ func (r *PullRequestRepo) SetMerged(
 		return domain.PullRequest{}, fmt.Errorf("exec update PR: %w", err)
 	}
 
-	pr.Status = toDomainPullRequestStatus(statusSmallint)
+	pr.Status = domain.PullRequestStatus(statusText)
 
 	return pr, nil
 }
+
+// SetStatus moves the PR to status if it is currently in one of from;
+// ErrNotFound when no such PR exists, e.g. the status was changed concurrently
+func (r *PullRequestRepo) SetStatus(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	from []domain.PullRequestStatus,
+	status domain.PullRequestStatus,
+) (domain.PullRequest, error) {
+	fromStatuses := make([]string, len(from))
+	for i, st := range from {
+		fromStatuses[i] = string(st)
+	}
+
+	sql, args, err := r.Builder.
+		Update("pull_requests").
+		Set("pr_status", string(status)).
+		Where(squirrel.Eq{"id": pullRequestId}).
+		Where(squirrel.Eq{"pr_status": fromStatuses}).
+		Suffix("RETURNING id, pr_name, author_id, pr_status, created_at, merged_at").
+		ToSql()
+	if err != nil {
+		return domain.PullRequest{}, fmt.Errorf("build update PR status sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var pr domain.PullRequest
+	var statusText string
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&pr.PullRequestId,
+		&pr.PullRequestName,
+		&pr.AuthorId,
+		&statusText,
+		&pr.CreatedAt,
+		&pr.MergedAt,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.PullRequest{}, repoerrors.ErrNotFound
+		}
+		return domain.PullRequest{}, fmt.Errorf("exec update PR status: %w", err)
+	}
+
+	pr.Status = domain.PullRequestStatus(statusText)
+
+	return pr, nil
+}
+
+// ListPullRequests returns up to filter.Limit PRs ordered by (created_at, id)
+// starting right after filter.After
+func (r *PullRequestRepo) ListPullRequests(
+	ctx context.Context,
+	filter domain.PullRequestFilter,
+) ([]domain.PullRequest, error) {
+	builder := r.Builder.
+		Select("pr.id", "pr.pr_name", "pr.author_id", "pr.pr_status", "pr.created_at", "pr.merged_at").
+		From("pull_requests pr")
+
+	if filter.Status != nil {
+		builder = builder.Where(squirrel.Eq{"pr.pr_status": string(*filter.Status)})
+	}
+	if filter.AuthorId != nil {
+		builder = builder.Where(squirrel.Eq{"pr.author_id": *filter.AuthorId})
+	}
+	if filter.TeamId != nil {
+		builder = builder.
+			Join("users a ON a.id = pr.author_id").
+			Where(squirrel.Eq{"a.team_id": *filter.TeamId})
+	}
+	if filter.ReviewerId != nil {
+		builder = builder.Where(
+			"exists (select 1 from pr_reviewers rv where rv.pr_id = pr.id and rv.user_id = ?)",
+			*filter.ReviewerId,
+		)
+	}
+	if filter.CreatedAfter != nil {
+		builder = builder.Where(squirrel.GtOrEq{"pr.created_at": *filter.CreatedAfter})
+	}
+	if filter.CreatedBefore != nil {
+		builder = builder.Where(squirrel.Lt{"pr.created_at": *filter.CreatedBefore})
+	}
+
+	direction, cmp := "DESC", "<"
+	if filter.Order == domain.SortOrderAsc {
+		direction, cmp = "ASC", ">"
+	}
+	if filter.After != nil {
+		builder = builder.Where(
+			"(pr.created_at, pr.id) "+cmp+" (?, ?)",
+			filter.After.CreatedAt, filter.After.PullRequestId,
+		)
+	}
+
+	sql, args, err := builder.
+		OrderBy("pr.created_at "+direction, "pr.id "+direction).
+		Limit(uint64(filter.Limit)).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build list PRs sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query list PRs: %w", err)
+	}
+	defer rows.Close()
+
+	prs := make([]domain.PullRequest, 0, filter.Limit)
+	for rows.Next() {
+		var pr domain.PullRequest
+		var statusText string
+		if err := rows.Scan(
+			&pr.PullRequestId,
+			&pr.PullRequestName,
+			&pr.AuthorId,
+			&statusText,
+			&pr.CreatedAt,
+			&pr.MergedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan pr row: %w", err)
+		}
+		pr.Status = domain.PullRequestStatus(statusText)
+		prs = append(prs, pr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return prs, nil
+}
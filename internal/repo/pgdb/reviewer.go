@@ -1,14 +1,18 @@
 package pgdb
 
 import (
+	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/repo/repoerrors"
 	"avito-test-applicant/pkg/postgres"
 	"context"
+	"errors"
+	"time"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 
 	"github.com/Masterminds/squirrel"
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
 )
 
 type ReviewerRepo struct {
@@ -20,15 +24,73 @@ func NewReviewerRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *ReviewerR
 	return &ReviewerRepo{pg, getter}
 }
 
+// fromFallbackExpr tells, at assignment time, whether the reviewer is outside
+// the PR author's team; args are the user id and the PR id. It is stored so
+// later team moves do not change where the reviewer came from
+const fromFallbackExpr = `(select u.team_id from users u where u.id = ?) is distinct from
+	(select a.team_id from pull_requests p join users a on a.id = p.author_id where p.id = ?)`
+
+// ensureNotMerged locks the PR rows against a concurrent merge and rejects
+// changes to reviewers of merged PRs; missing PRs are left to the caller.
+// Rows are locked whatever their status and read to the end, since a lock is
+// taken as its row is fetched; the id order keeps concurrent callers from
+// deadlocking
+func (r *ReviewerRepo) ensureNotMerged(
+	ctx context.Context,
+	pullRequestIds ...uuid.UUID,
+) error {
+	sql, args, err := r.Builder.
+		Select("id", "pr_status").
+		From("pull_requests").
+		Where(squirrel.Eq{"id": pullRequestIds}).
+		OrderBy("id").
+		Suffix("FOR SHARE").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	merged := false
+	for rows.Next() {
+		var id uuid.UUID
+		var status string
+		if err := rows.Scan(&id, &status); err != nil {
+			return err
+		}
+		if domain.PullRequestStatus(status) == domain.PullRequestStatusMERGED {
+			merged = true
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+	if merged {
+		return repoerrors.ErrMerged
+	}
+	return nil
+}
+
 func (r *ReviewerRepo) AssignOne(
 	ctx context.Context,
 	pullRequestId uuid.UUID,
 	userId uuid.UUID,
 ) error {
+	if err := r.ensureNotMerged(ctx, pullRequestId); err != nil {
+		return err
+	}
+
 	sql, args, err := r.Builder.
 		Insert("pr_reviewers").
-		Columns("pr_id", "user_id").
-		Values(pullRequestId, userId).
+		Columns("pr_id", "user_id", "from_fallback").
+		Values(pullRequestId, userId, squirrel.Expr(fromFallbackExpr, userId, pullRequestId)).
 		Suffix("ON CONFLICT (pr_id, user_id) DO NOTHING").
 		ToSql()
 	if err != nil {
@@ -49,6 +111,10 @@ func (r *ReviewerRepo) RemoveOne(
 	pullRequestId uuid.UUID,
 	userId uuid.UUID,
 ) error {
+	if err := r.ensureNotMerged(ctx, pullRequestId); err != nil {
+		return err
+	}
+
 	sql, args, err := r.Builder.
 		Delete("pr_reviewers").
 		Where(squirrel.Eq{
@@ -74,6 +140,89 @@ func (r *ReviewerRepo) RemoveOne(
 	return nil
 }
 
+func (r *ReviewerRepo) AssignMany(
+	ctx context.Context,
+	assignments []domain.ReviewAssignment,
+) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	if err := r.ensureNotMerged(ctx, assignmentPullRequestIds(assignments)...); err != nil {
+		return err
+	}
+
+	builder := r.Builder.
+		Insert("pr_reviewers").
+		Columns("pr_id", "user_id", "from_fallback")
+	for _, a := range assignments {
+		builder = builder.Values(a.PullRequestId, a.UserId, squirrel.Expr(fromFallbackExpr, a.UserId, a.PullRequestId))
+	}
+
+	sql, args, err := builder.
+		Suffix("ON CONFLICT (pr_id, user_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	_, err = conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *ReviewerRepo) RemoveMany(
+	ctx context.Context,
+	assignments []domain.ReviewAssignment,
+) error {
+	if len(assignments) == 0 {
+		return nil
+	}
+	if err := r.ensureNotMerged(ctx, assignmentPullRequestIds(assignments)...); err != nil {
+		return err
+	}
+
+	pairs := make(squirrel.Or, len(assignments))
+	for i, a := range assignments {
+		pairs[i] = squirrel.Eq{
+			"pr_id":   a.PullRequestId,
+			"user_id": a.UserId,
+		}
+	}
+
+	sql, args, err := r.Builder.
+		Delete("pr_reviewers").
+		Where(pairs).
+		ToSql()
+	if err != nil {
+		return err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	cmdTag, err := conn.Exec(ctx, sql, args...)
+	if err != nil {
+		return err
+	}
+
+	if cmdTag.RowsAffected() != int64(len(assignments)) {
+		return repoerrors.ErrNotFound
+	}
+
+	return nil
+}
+
+func assignmentPullRequestIds(assignments []domain.ReviewAssignment) []uuid.UUID {
+	ids := make([]uuid.UUID, len(assignments))
+	for i, a := range assignments {
+		ids[i] = a.PullRequestId
+	}
+	return ids
+}
+
 func (r *ReviewerRepo) ListReviewers(
 	ctx context.Context,
 	pullRequestId uuid.UUID,
@@ -113,6 +262,47 @@ func (r *ReviewerRepo) ListReviewers(
 	return reviewers, nil
 }
 
+func (r *ReviewerRepo) ListReviewersByPullRequestIds(
+	ctx context.Context,
+	pullRequestIds []uuid.UUID,
+) (map[uuid.UUID][]uuid.UUID, error) {
+	reviewers := make(map[uuid.UUID][]uuid.UUID, len(pullRequestIds))
+	if len(pullRequestIds) == 0 {
+		return reviewers, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("pr_id", "user_id").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"pr_id": pullRequestIds}).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var prId, userId uuid.UUID
+		if err := rows.Scan(&prId, &userId); err != nil {
+			return nil, err
+		}
+		reviewers[prId] = append(reviewers[prId], userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviewers, nil
+}
+
 func (r *ReviewerRepo) ListByUserId(
 	ctx context.Context,
 	userId uuid.UUID,
@@ -149,3 +339,340 @@ func (r *ReviewerRepo) ListByUserId(
 
 	return prIDs, nil
 }
+
+func (r *ReviewerRepo) CountOpenByUserIds(
+	ctx context.Context,
+	userIds []uuid.UUID,
+) (map[uuid.UUID]int, error) {
+	counts := make(map[uuid.UUID]int, len(userIds))
+	if len(userIds) == 0 {
+		return counts, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("rv.user_id", "count(*)").
+		From("pr_reviewers rv").
+		Join("pull_requests pr ON pr.id = rv.pr_id").
+		Where(squirrel.Eq{
+			"rv.user_id":   userIds,
+			"pr.pr_status": string(domain.PullRequestStatusOPEN),
+		}).
+		GroupBy("rv.user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userId uuid.UUID
+		var count int
+		if err := rows.Scan(&userId, &count); err != nil {
+			return nil, err
+		}
+		counts[userId] = count
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return counts, nil
+}
+
+func (r *ReviewerRepo) LastAssignedAtByUserIds(
+	ctx context.Context,
+	userIds []uuid.UUID,
+) (map[uuid.UUID]time.Time, error) {
+	lastAssigned := make(map[uuid.UUID]time.Time, len(userIds))
+	if len(userIds) == 0 {
+		return lastAssigned, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("user_id", "max(assigned_at)").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"user_id": userIds}).
+		GroupBy("user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var userId uuid.UUID
+		var assignedAt time.Time
+		if err := rows.Scan(&userId, &assignedAt); err != nil {
+			return nil, err
+		}
+		lastAssigned[userId] = assignedAt
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return lastAssigned, nil
+}
+
+func (r *ReviewerRepo) ListOpenByUserId(
+	ctx context.Context,
+	userId uuid.UUID,
+) ([]uuid.UUID, error) {
+	sql, args, err := r.Builder.
+		Select("rv.pr_id").
+		From("pr_reviewers rv").
+		Join("pull_requests pr ON pr.id = rv.pr_id").
+		Where(squirrel.Eq{
+			"rv.user_id":   userId,
+			"pr.pr_status": string(domain.PullRequestStatusOPEN),
+		}).
+		OrderBy("pr.created_at", "rv.pr_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		prIDs = append(prIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return prIDs, nil
+}
+
+func (r *ReviewerRepo) ListOpenByUserIds(
+	ctx context.Context,
+	userIds []uuid.UUID,
+) ([]domain.ReviewAssignment, error) {
+	if len(userIds) == 0 {
+		return []domain.ReviewAssignment{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("rv.pr_id", "rv.user_id").
+		From("pr_reviewers rv").
+		Join("pull_requests pr ON pr.id = rv.pr_id").
+		Where(squirrel.Eq{
+			"rv.user_id":   userIds,
+			"pr.pr_status": string(domain.PullRequestStatusOPEN),
+		}).
+		OrderBy("pr.created_at", "rv.pr_id", "rv.user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []domain.ReviewAssignment
+	for rows.Next() {
+		var a domain.ReviewAssignment
+		if err := rows.Scan(&a.PullRequestId, &a.UserId); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *ReviewerRepo) SetReviewState(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	userId uuid.UUID,
+	state domain.ReviewState,
+) (domain.Review, error) {
+	if err := r.ensureNotMerged(ctx, pullRequestId); err != nil {
+		return domain.Review{}, err
+	}
+
+	sql, args, err := r.Builder.
+		Update("pr_reviewers").
+		Set("review_state", string(state)).
+		Set("reviewed_at", squirrel.Expr("now()")).
+		Where(squirrel.Eq{
+			"pr_id":   pullRequestId,
+			"user_id": userId,
+		}).
+		Suffix("RETURNING pr_id, user_id, review_state, assigned_at, reviewed_at").
+		ToSql()
+	if err != nil {
+		return domain.Review{}, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var review domain.Review
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&review.PullRequestId,
+		&review.UserId,
+		&review.State,
+		&review.AssignedAt,
+		&review.ReviewedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Review{}, repoerrors.ErrNotFound
+		}
+		return domain.Review{}, err
+	}
+
+	return review, nil
+}
+
+func (r *ReviewerRepo) ListReviews(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+) ([]domain.Review, error) {
+	sql, args, err := r.Builder.
+		Select("pr_id", "user_id", "review_state", "assigned_at", "reviewed_at").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"pr_id": pullRequestId}).
+		OrderBy("assigned_at", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryReviews(ctx, sql, args)
+}
+
+// ListFallbackReviewers returns reviewers of the PR who were outside the
+// author's team when assigned
+func (r *ReviewerRepo) ListFallbackReviewers(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+) ([]uuid.UUID, error) {
+	sql, args, err := r.Builder.
+		Select("user_id").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"pr_id": pullRequestId, "from_fallback": true}).
+		OrderBy("assigned_at", "user_id").
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviewers := []uuid.UUID{}
+	for rows.Next() {
+		var userId uuid.UUID
+		if err := rows.Scan(&userId); err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, userId)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return reviewers, nil
+}
+
+// ListReviewsByUserId returns all reviews of the user, optionally only those
+// in the given state
+func (r *ReviewerRepo) ListReviewsByUserId(
+	ctx context.Context,
+	userId uuid.UUID,
+	state *domain.ReviewState,
+) ([]domain.Review, error) {
+	builder := r.Builder.
+		Select("pr_id", "user_id", "review_state", "assigned_at", "reviewed_at").
+		From("pr_reviewers").
+		Where(squirrel.Eq{"user_id": userId}).
+		OrderBy("assigned_at", "pr_id")
+	if state != nil {
+		builder = builder.Where(squirrel.Eq{"review_state": string(*state)})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	return r.queryReviews(ctx, sql, args)
+}
+
+func (r *ReviewerRepo) queryReviews(
+	ctx context.Context,
+	sql string,
+	args []any,
+) ([]domain.Review, error) {
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := []domain.Review{}
+	for rows.Next() {
+		var review domain.Review
+		if err := rows.Scan(
+			&review.PullRequestId,
+			&review.UserId,
+			&review.State,
+			&review.AssignedAt,
+			&review.ReviewedAt,
+		); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return reviews, nil
+}
@@ -0,0 +1,104 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+)
+
+type ReviewerEventRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewReviewerEventRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *ReviewerEventRepo {
+	return &ReviewerEventRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// Append inserts events in one statement; Id and CreatedAt are ignored
+func (r *ReviewerEventRepo) Append(
+	ctx context.Context,
+	events ...domain.ReviewerEvent,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	builder := r.Builder.
+		Insert("reviewer_events").
+		Columns("pr_id", "event_type", "user_id", "replaced_by", "actor_id", "reason", "created_at")
+	for _, e := range events {
+		builder = builder.Values(e.PullRequestId, string(e.Type), e.UserId, e.ReplacedBy, e.ActorId, e.Reason, now)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert reviewer events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec insert reviewer events: %w", err)
+	}
+	return nil
+}
+
+// ListByPullRequestId returns the PR's events in the order they were written
+func (r *ReviewerEventRepo) ListByPullRequestId(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+) ([]domain.ReviewerEvent, error) {
+	sql, args, err := r.Builder.
+		Select("id", "pr_id", "event_type", "user_id", "replaced_by", "actor_id", "reason", "created_at").
+		From("reviewer_events").
+		Where(squirrel.Eq{"pr_id": pullRequestId}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select reviewer events sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reviewer events: %w", err)
+	}
+	defer rows.Close()
+
+	events := make([]domain.ReviewerEvent, 0)
+	for rows.Next() {
+		var e domain.ReviewerEvent
+		var eventType string
+		if err := rows.Scan(
+			&e.Id,
+			&e.PullRequestId,
+			&eventType,
+			&e.UserId,
+			&e.ReplacedBy,
+			&e.ActorId,
+			&e.Reason,
+			&e.CreatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan reviewer event row: %w", err)
+		}
+		e.Type = domain.ReviewerEventType(eventType)
+		events = append(events, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("rows error: %w", err)
+	}
+
+	return events, nil
+}
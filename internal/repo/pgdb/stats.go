@@ -0,0 +1,192 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+)
+
+type StatsRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewStatsRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *StatsRepo {
+	return &StatsRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// GetStats aggregates review load over all users, or over members of the team
+// when teamId is set
+func (r *StatsRepo) GetStats(
+	ctx context.Context,
+	teamId *uuid.UUID,
+) (domain.Stats, error) {
+	reviewers, err := r.reviewerStats(ctx, teamId)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+
+	authors, err := r.authorStats(ctx, teamId)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+
+	stats, err := r.pullRequestStats(ctx, teamId)
+	if err != nil {
+		return domain.Stats{}, err
+	}
+
+	stats.Reviewers = reviewers
+	stats.Authors = authors
+	return stats, nil
+}
+
+func (r *StatsRepo) reviewerStats(
+	ctx context.Context,
+	teamId *uuid.UUID,
+) ([]domain.ReviewerStats, error) {
+	builder := r.Builder.
+		Select(
+			"u.id",
+			"u.username",
+			"count(pr.id)",
+			"count(pr.id) filter (where pr.pr_status = 'OPEN')",
+			"count(pr.id) filter (where pr.pr_status = 'MERGED')",
+		).
+		From("users u").
+		LeftJoin("pr_reviewers rv ON rv.user_id = u.id").
+		LeftJoin("pull_requests pr ON pr.id = rv.pr_id").
+		GroupBy("u.id", "u.username").
+		OrderBy("count(pr.id) DESC", "u.username")
+	if teamId != nil {
+		builder = builder.Where(squirrel.Eq{"u.team_id": *teamId})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build reviewer stats sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query reviewer stats: %w", err)
+	}
+	defer rows.Close()
+
+	reviewers := []domain.ReviewerStats{}
+	for rows.Next() {
+		var s domain.ReviewerStats
+		if err := rows.Scan(
+			&s.UserId,
+			&s.Username,
+			&s.TotalAssignments,
+			&s.OpenReviews,
+			&s.MergedReviews,
+		); err != nil {
+			return nil, fmt.Errorf("scan reviewer stats row: %w", err)
+		}
+		reviewers = append(reviewers, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate reviewer stats rows: %w", err)
+	}
+
+	return reviewers, nil
+}
+
+func (r *StatsRepo) authorStats(
+	ctx context.Context,
+	teamId *uuid.UUID,
+) ([]domain.AuthorStats, error) {
+	builder := r.Builder.
+		Select("u.id", "u.username", "count(pr.id)").
+		From("users u").
+		Join("pull_requests pr ON pr.author_id = u.id").
+		GroupBy("u.id", "u.username").
+		OrderBy("count(pr.id) DESC", "u.username")
+	if teamId != nil {
+		builder = builder.Where(squirrel.Eq{"u.team_id": *teamId})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build author stats sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query author stats: %w", err)
+	}
+	defer rows.Close()
+
+	authors := []domain.AuthorStats{}
+	for rows.Next() {
+		var s domain.AuthorStats
+		if err := rows.Scan(&s.UserId, &s.Username, &s.PullRequests); err != nil {
+			return nil, fmt.Errorf("scan author stats row: %w", err)
+		}
+		authors = append(authors, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate author stats rows: %w", err)
+	}
+
+	return authors, nil
+}
+
+func (r *StatsRepo) pullRequestStats(
+	ctx context.Context,
+	teamId *uuid.UUID,
+) (domain.Stats, error) {
+	// number of reviewers per PR, zero for PRs without any
+	perPullRequest := r.Builder.
+		Select("pr.id", "count(rv.user_id) AS reviewers").
+		From("pull_requests pr").
+		LeftJoin("pr_reviewers rv ON rv.pr_id = pr.id").
+		GroupBy("pr.id")
+	if teamId != nil {
+		perPullRequest = perPullRequest.
+			Join("users a ON a.id = pr.author_id").
+			Where(squirrel.Eq{"a.team_id": *teamId})
+	}
+
+	sql, args, err := r.Builder.
+		Select(
+			"count(*)",
+			"coalesce(avg(s.reviewers), 0)::float8",
+			"count(*) filter (where s.reviewers = 0)",
+		).
+		FromSelect(perPullRequest, "s").
+		ToSql()
+	if err != nil {
+		return domain.Stats{}, fmt.Errorf("build pull request stats sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var s domain.Stats
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&s.TotalPullRequests,
+		&s.AvgReviewersPerPullRequest,
+		&s.PullRequestsWithoutReviewers,
+	)
+	if err != nil {
+		return domain.Stats{}, fmt.Errorf("query pull request stats: %w", err)
+	}
+
+	return s, nil
+}
@@ -32,12 +32,13 @@ func (r *TeamRepo) CreateTeam(
 	ctx context.Context,
 	teamId uuid.UUID,
 	teamName string,
+	reviewersRequired int,
 ) (domain.Team, error) {
 	query, args, err := r.Builder.
 		Insert("teams").
-		Columns("id", "team_name").
-		Values(teamId, teamName).
-		Suffix("RETURNING id, team_name").
+		Columns("id", "team_name", "reviewers_required").
+		Values(teamId, teamName, reviewersRequired).
+		Suffix("RETURNING id, team_name, reviewers_required").
 		ToSql()
 	if err != nil {
 		return domain.Team{}, err
@@ -49,6 +50,7 @@ func (r *TeamRepo) CreateTeam(
 	err = conn.QueryRow(ctx, query, args...).Scan(
 		&t.TeamId,
 		&t.TeamName,
+		&t.ReviewersRequired,
 	)
 	if err != nil {
 		if pgErr, ok := err.(*pgconn.PgError); ok {
@@ -67,7 +69,7 @@ func (r *TeamRepo) GetTeamByName(
 	teamName string,
 ) (domain.Team, error) {
 	query, args, err := r.Builder.
-		Select("id", "team_name").
+		Select("id", "team_name", "reviewers_required").
 		From("teams").
 		Where(squirrel.Eq{"team_name": teamName}).
 		Limit(1).
@@ -82,6 +84,7 @@ func (r *TeamRepo) GetTeamByName(
 	err = conn.QueryRow(ctx, query, args...).Scan(
 		&t.TeamId,
 		&t.TeamName,
+		&t.ReviewersRequired,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -98,7 +101,7 @@ func (r *TeamRepo) GetTeamById(
 	teamId uuid.UUID,
 ) (domain.Team, error) {
 	query, args, err := r.Builder.
-		Select("id", "team_name").
+		Select("id", "team_name", "reviewers_required").
 		From("teams").
 		Where(squirrel.Eq{"id": teamId}).
 		Limit(1).
@@ -113,6 +116,7 @@ func (r *TeamRepo) GetTeamById(
 	err = conn.QueryRow(ctx, query, args...).Scan(
 		&t.TeamId,
 		&t.TeamName,
+		&t.ReviewersRequired,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -123,3 +127,153 @@ func (r *TeamRepo) GetTeamById(
 
 	return t, nil
 }
+
+func (r *TeamRepo) SetReviewersRequired(
+	ctx context.Context,
+	teamId uuid.UUID,
+	reviewersRequired int,
+) (domain.Team, error) {
+	query, args, err := r.Builder.
+		Update("teams").
+		Set("reviewers_required", reviewersRequired).
+		Where(squirrel.Eq{"id": teamId}).
+		Suffix("RETURNING id, team_name, reviewers_required").
+		ToSql()
+	if err != nil {
+		return domain.Team{}, fmt.Errorf("build update team sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var t domain.Team
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&t.TeamId,
+		&t.TeamName,
+		&t.ReviewersRequired,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Team{}, repoerrors.ErrNotFound
+		}
+		return domain.Team{}, fmt.Errorf("exec update team: %w", err)
+	}
+
+	return t, nil
+}
+
+func (r *TeamRepo) RenameTeam(
+	ctx context.Context,
+	teamId uuid.UUID,
+	teamName string,
+) (domain.Team, error) {
+	query, args, err := r.Builder.
+		Update("teams").
+		Set("team_name", teamName).
+		Where(squirrel.Eq{"id": teamId}).
+		Suffix("RETURNING id, team_name, reviewers_required").
+		ToSql()
+	if err != nil {
+		return domain.Team{}, fmt.Errorf("build rename team sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var t domain.Team
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&t.TeamId,
+		&t.TeamName,
+		&t.ReviewersRequired,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Team{}, repoerrors.ErrNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.Team{}, repoerrors.ErrAlreadyExists
+		}
+		return domain.Team{}, fmt.Errorf("exec rename team: %w", err)
+	}
+
+	return t, nil
+}
+
+func (r *TeamRepo) ListFallbackTeams(
+	ctx context.Context,
+	teamId uuid.UUID,
+) ([]domain.Team, error) {
+	query, args, err := r.Builder.
+		Select("t.id", "t.team_name", "t.reviewers_required").
+		From("team_fallbacks f").
+		Join("teams t ON t.id = f.fallback_team_id").
+		Where(squirrel.Eq{"f.team_id": teamId}).
+		OrderBy("f.priority").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select fallback teams sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query fallback teams: %w", err)
+	}
+	defer rows.Close()
+
+	teams := make([]domain.Team, 0)
+	for rows.Next() {
+		var t domain.Team
+		if err := rows.Scan(&t.TeamId, &t.TeamName, &t.ReviewersRequired); err != nil {
+			return nil, fmt.Errorf("scan fallback team row: %w", err)
+		}
+		teams = append(teams, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate fallback team rows: %w", err)
+	}
+
+	return teams, nil
+}
+
+// ReplaceFallbackTeams overwrites the fallback list, slice order becomes priority
+func (r *TeamRepo) ReplaceFallbackTeams(
+	ctx context.Context,
+	teamId uuid.UUID,
+	fallbackTeamIds []uuid.UUID,
+) error {
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	query, args, err := r.Builder.
+		Delete("team_fallbacks").
+		Where(squirrel.Eq{"team_id": teamId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build delete fallback teams sql: %w", err)
+	}
+	if _, err := conn.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec delete fallback teams: %w", err)
+	}
+
+	if len(fallbackTeamIds) == 0 {
+		return nil
+	}
+
+	insert := r.Builder.
+		Insert("team_fallbacks").
+		Columns("team_id", "fallback_team_id", "priority")
+	for i, fallbackId := range fallbackTeamIds {
+		insert = insert.Values(teamId, fallbackId, i)
+	}
+
+	query, args, err = insert.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert fallback teams sql: %w", err)
+	}
+	if _, err := conn.Exec(ctx, query, args...); err != nil {
+		return fmt.Errorf("exec insert fallback teams: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,94 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type TeamMergePolicyRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewTeamMergePolicyRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *TeamMergePolicyRepo {
+	return &TeamMergePolicyRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+func (r *TeamMergePolicyRepo) GetByTeamId(
+	ctx context.Context,
+	teamId uuid.UUID,
+) (domain.MergePolicy, error) {
+	query, args, err := r.Builder.
+		Select("team_id", "required_approvals", "require_lead_approval", "lead_user_id").
+		From("team_merge_policies").
+		Where(squirrel.Eq{"team_id": teamId}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return domain.MergePolicy{}, fmt.Errorf("build select merge policy sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var p domain.MergePolicy
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&p.TeamId,
+		&p.RequiredApprovals,
+		&p.RequireLeadApproval,
+		&p.LeadUserId,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.MergePolicy{}, repoerrors.ErrNotFound
+		}
+		return domain.MergePolicy{}, fmt.Errorf("query merge policy: %w", err)
+	}
+
+	return p, nil
+}
+
+func (r *TeamMergePolicyRepo) Upsert(
+	ctx context.Context,
+	policy domain.MergePolicy,
+) (domain.MergePolicy, error) {
+	query, args, err := r.Builder.
+		Insert("team_merge_policies").
+		Columns("team_id", "required_approvals", "require_lead_approval", "lead_user_id").
+		Values(policy.TeamId, policy.RequiredApprovals, policy.RequireLeadApproval, policy.LeadUserId).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET " +
+			"required_approvals = EXCLUDED.required_approvals, " +
+			"require_lead_approval = EXCLUDED.require_lead_approval, " +
+			"lead_user_id = EXCLUDED.lead_user_id " +
+			"RETURNING team_id, required_approvals, require_lead_approval, lead_user_id").
+		ToSql()
+	if err != nil {
+		return domain.MergePolicy{}, fmt.Errorf("build upsert merge policy sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var p domain.MergePolicy
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&p.TeamId,
+		&p.RequiredApprovals,
+		&p.RequireLeadApproval,
+		&p.LeadUserId,
+	)
+	if err != nil {
+		return domain.MergePolicy{}, fmt.Errorf("exec upsert merge policy: %w", err)
+	}
+
+	return p, nil
+}
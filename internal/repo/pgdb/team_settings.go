@@ -0,0 +1,87 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type TeamSettingsRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewTeamSettingsRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *TeamSettingsRepo {
+	return &TeamSettingsRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+func (r *TeamSettingsRepo) GetByTeamId(
+	ctx context.Context,
+	teamId uuid.UUID,
+) (domain.TeamSettings, error) {
+	query, args, err := r.Builder.
+		Select("team_id", "selection_strategy").
+		From("team_settings").
+		Where(squirrel.Eq{"team_id": teamId}).
+		Limit(1).
+		ToSql()
+	if err != nil {
+		return domain.TeamSettings{}, fmt.Errorf("build select team settings sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var ts domain.TeamSettings
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&ts.TeamId,
+		&ts.SelectionStrategy,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.TeamSettings{}, repoerrors.ErrNotFound
+		}
+		return domain.TeamSettings{}, fmt.Errorf("query team settings: %w", err)
+	}
+
+	return ts, nil
+}
+
+func (r *TeamSettingsRepo) Upsert(
+	ctx context.Context,
+	settings domain.TeamSettings,
+) (domain.TeamSettings, error) {
+	query, args, err := r.Builder.
+		Insert("team_settings").
+		Columns("team_id", "selection_strategy").
+		Values(settings.TeamId, settings.SelectionStrategy).
+		Suffix("ON CONFLICT (team_id) DO UPDATE SET selection_strategy = EXCLUDED.selection_strategy " +
+			"RETURNING team_id, selection_strategy").
+		ToSql()
+	if err != nil {
+		return domain.TeamSettings{}, fmt.Errorf("build upsert team settings sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var ts domain.TeamSettings
+	err = conn.QueryRow(ctx, query, args...).Scan(
+		&ts.TeamId,
+		&ts.SelectionStrategy,
+	)
+	if err != nil {
+		return domain.TeamSettings{}, fmt.Errorf("exec upsert team settings: %w", err)
+	}
+
+	return ts, nil
+}
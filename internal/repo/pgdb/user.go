@@ -15,6 +15,9 @@ import (
 	"github.com/jackc/pgx/v5/pgconn"
 )
 
+// usernameConstraint keeps usernames unique within a team
+const usernameConstraint = "unique_team_username"
+
 type UserRepo struct {
 	*postgres.Postgres
 	getter *trmpgx.CtxGetter
@@ -56,7 +59,10 @@ func (r *UserRepo) CreateUser(
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return domain.User{}, repoerrors.ErrUsernameTakenInTeam
+			if pgErr.ConstraintName == usernameConstraint {
+				return domain.User{}, repoerrors.ErrUsernameTakenInTeam
+			}
+			return domain.User{}, repoerrors.ErrAlreadyExists
 		}
 		return domain.User{}, fmt.Errorf("exec insert user: %w", err)
 	}
@@ -131,6 +137,55 @@ func (r *UserRepo) SetIsActive(
 	return u, nil
 }
 
+func (r *UserRepo) SetIsActiveByIds(
+	ctx context.Context,
+	userIds []uuid.UUID,
+	isActive bool,
+) ([]domain.User, error) {
+	if len(userIds) == 0 {
+		return []domain.User{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Update("users").
+		Set("is_active", isActive).
+		Where(squirrel.Eq{"id": userIds}).
+		Suffix("RETURNING id, username, team_id, is_active").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build update users sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("exec update users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0, len(userIds))
+	for rows.Next() {
+		var u domain.User
+		err := rows.Scan(
+			&u.UserId,
+			&u.Username,
+			&u.TeamId,
+			&u.IsActive,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan user row: %w", err)
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate user rows: %w", err)
+	}
+
+	return users, nil
+}
+
 func (r *UserRepo) GetUsersByTeam(
 	ctx context.Context,
 	teamId uuid.UUID,
@@ -174,6 +229,85 @@ func (r *UserRepo) GetUsersByTeam(
 	return users, nil
 }
 
+// GetUsersByIds returns the users that exist, in no particular order
+func (r *UserRepo) GetUsersByIds(
+	ctx context.Context,
+	userIds []uuid.UUID,
+) ([]domain.User, error) {
+	if len(userIds) == 0 {
+		return []domain.User{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("id", "username", "team_id", "is_active").
+		From("users").
+		Where(squirrel.Eq{"id": userIds}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select users by ids sql: %w", err)
+	}
+
+	return r.queryUsers(ctx, sql, args)
+}
+
+// GetActiveUsersByTeamIds returns active members of all the given teams
+// in one query, ordered by team and id
+func (r *UserRepo) GetActiveUsersByTeamIds(
+	ctx context.Context,
+	teamIds []uuid.UUID,
+) ([]domain.User, error) {
+	if len(teamIds) == 0 {
+		return []domain.User{}, nil
+	}
+
+	sql, args, err := r.Builder.
+		Select("id", "username", "team_id", "is_active").
+		From("users").
+		Where(squirrel.Eq{"team_id": teamIds, "is_active": true}).
+		OrderBy("team_id", "id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select active users by teams sql: %w", err)
+	}
+
+	return r.queryUsers(ctx, sql, args)
+}
+
+func (r *UserRepo) queryUsers(
+	ctx context.Context,
+	sql string,
+	args []any,
+) ([]domain.User, error) {
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query users: %w", err)
+	}
+	defer rows.Close()
+
+	users := make([]domain.User, 0)
+	for rows.Next() {
+		var u domain.User
+		err := rows.Scan(
+			&u.UserId,
+			&u.Username,
+			&u.TeamId,
+			&u.IsActive,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("scan user row: %w", err)
+		}
+		users = append(users, u)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate user rows: %w", err)
+	}
+
+	return users, nil
+}
+
 func (r *UserRepo) UpdateUser(
 	ctx context.Context,
 	user domain.User,
@@ -203,8 +337,88 @@ func (r *UserRepo) UpdateUser(
 		if err == pgx.ErrNoRows {
 			return domain.User{}, repoerrors.ErrNotFound
 		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.User{}, repoerrors.ErrUsernameTakenInTeam
+		}
 		return domain.User{}, fmt.Errorf("exec update user: %w", err)
 	}
 
 	return u, nil
 }
+
+// MoveToTeam changes the user's team; the username must be free in the new team
+func (r *UserRepo) MoveToTeam(
+	ctx context.Context,
+	userId uuid.UUID,
+	teamId uuid.UUID,
+) (domain.User, error) {
+	sql, args, err := r.Builder.
+		Update("users").
+		Set("team_id", teamId).
+		Where(squirrel.Eq{"id": userId}).
+		Suffix("RETURNING id, username, team_id, is_active").
+		ToSql()
+	if err != nil {
+		return domain.User{}, fmt.Errorf("build move user sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var u domain.User
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&u.UserId,
+		&u.Username,
+		&u.TeamId,
+		&u.IsActive,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.User{}, repoerrors.ErrNotFound
+		}
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return domain.User{}, repoerrors.ErrUsernameTakenInTeam
+		}
+		return domain.User{}, fmt.Errorf("exec move user: %w", err)
+	}
+
+	return u, nil
+}
+
+// RemoveFromTeam detaches the user from their team and deactivates them.
+// The row is kept because PRs and reviews still reference it; TeamId of
+// the returned user is uuid.Nil
+func (r *UserRepo) RemoveFromTeam(
+	ctx context.Context,
+	userId uuid.UUID,
+) (domain.User, error) {
+	sql, args, err := r.Builder.
+		Update("users").
+		Set("team_id", nil).
+		Set("is_active", false).
+		Where(squirrel.Eq{"id": userId}).
+		Suffix("RETURNING id, username, team_id, is_active").
+		ToSql()
+	if err != nil {
+		return domain.User{}, fmt.Errorf("build remove user from team sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	var u domain.User
+	err = conn.QueryRow(ctx, sql, args...).Scan(
+		&u.UserId,
+		&u.Username,
+		&u.TeamId,
+		&u.IsActive,
+	)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return domain.User{}, repoerrors.ErrNotFound
+		}
+		return domain.User{}, fmt.Errorf("exec remove user from team: %w", err)
+	}
+
+	return u, nil
+}
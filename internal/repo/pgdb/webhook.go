@@ -0,0 +1,198 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+type WebhookRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewWebhookRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *WebhookRepo {
+	return &WebhookRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+func (r *WebhookRepo) CreateWebhook(
+	ctx context.Context,
+	webhook domain.Webhook,
+	secret string,
+) (domain.Webhook, error) {
+	sql, args, err := r.Builder.
+		Insert("webhooks").
+		Columns("id", "url", "secret", "event_types", "is_active", "created_at").
+		Values(webhook.WebhookId, webhook.URL, secret, eventTypesToStrings(webhook.EventTypes), webhook.IsActive, time.Now().UTC()).
+		Suffix("RETURNING id, url, event_types, is_active, created_at").
+		ToSql()
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("build insert webhook sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	created, err := scanWebhook(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("exec insert webhook: %w", err)
+	}
+
+	return created, nil
+}
+
+func (r *WebhookRepo) GetWebhookById(
+	ctx context.Context,
+	webhookId uuid.UUID,
+) (domain.Webhook, error) {
+	sql, args, err := r.Builder.
+		Select("id", "url", "event_types", "is_active", "created_at").
+		From("webhooks").
+		Where(squirrel.Eq{"id": webhookId}).
+		ToSql()
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("build select webhook sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	webhook, err := scanWebhook(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Webhook{}, repoerrors.ErrNotFound
+		}
+		return domain.Webhook{}, fmt.Errorf("query webhook: %w", err)
+	}
+
+	return webhook, nil
+}
+
+// ListWebhooks returns webhooks in creation order, only active ones when
+// onlyActive is set
+func (r *WebhookRepo) ListWebhooks(
+	ctx context.Context,
+	onlyActive bool,
+) ([]domain.Webhook, error) {
+	builder := r.Builder.
+		Select("id", "url", "event_types", "is_active", "created_at").
+		From("webhooks").
+		OrderBy("created_at", "id")
+	if onlyActive {
+		builder = builder.Where(squirrel.Eq{"is_active": true})
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select webhooks sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query webhooks: %w", err)
+	}
+	defer rows.Close()
+
+	webhooks := make([]domain.Webhook, 0)
+	for rows.Next() {
+		webhook, err := scanWebhook(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scan webhook row: %w", err)
+		}
+		webhooks = append(webhooks, webhook)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook rows: %w", err)
+	}
+
+	return webhooks, nil
+}
+
+func (r *WebhookRepo) UpdateWebhook(
+	ctx context.Context,
+	webhook domain.Webhook,
+) (domain.Webhook, error) {
+	sql, args, err := r.Builder.
+		Update("webhooks").
+		Set("url", webhook.URL).
+		Set("event_types", eventTypesToStrings(webhook.EventTypes)).
+		Set("is_active", webhook.IsActive).
+		Where(squirrel.Eq{"id": webhook.WebhookId}).
+		Suffix("RETURNING id, url, event_types, is_active, created_at").
+		ToSql()
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("build update webhook sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	updated, err := scanWebhook(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Webhook{}, repoerrors.ErrNotFound
+		}
+		return domain.Webhook{}, fmt.Errorf("exec update webhook: %w", err)
+	}
+
+	return updated, nil
+}
+
+// DeleteWebhook removes the webhook together with its deliveries
+func (r *WebhookRepo) DeleteWebhook(
+	ctx context.Context,
+	webhookId uuid.UUID,
+) (domain.Webhook, error) {
+	sql, args, err := r.Builder.
+		Delete("webhooks").
+		Where(squirrel.Eq{"id": webhookId}).
+		Suffix("RETURNING id, url, event_types, is_active, created_at").
+		ToSql()
+	if err != nil {
+		return domain.Webhook{}, fmt.Errorf("build delete webhook sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	deleted, err := scanWebhook(conn.QueryRow(ctx, sql, args...))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Webhook{}, repoerrors.ErrNotFound
+		}
+		return domain.Webhook{}, fmt.Errorf("exec delete webhook: %w", err)
+	}
+
+	return deleted, nil
+}
+
+func scanWebhook(row pgx.Row) (domain.Webhook, error) {
+	var w domain.Webhook
+	var eventTypes []string
+	if err := row.Scan(&w.WebhookId, &w.URL, &eventTypes, &w.IsActive, &w.CreatedAt); err != nil {
+		return domain.Webhook{}, err
+	}
+	w.EventTypes = make([]domain.EventType, len(eventTypes))
+	for i, t := range eventTypes {
+		w.EventTypes[i] = domain.EventType(t)
+	}
+	return w, nil
+}
+
+func eventTypesToStrings(eventTypes []domain.EventType) []string {
+	out := make([]string, len(eventTypes))
+	for i, t := range eventTypes {
+		out[i] = string(t)
+	}
+	return out
+}
@@ -0,0 +1,245 @@
+package pgdb
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+)
+
+type WebhookDeliveryRepo struct {
+	*postgres.Postgres
+	getter *trmpgx.CtxGetter
+}
+
+func NewWebhookDeliveryRepo(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *WebhookDeliveryRepo {
+	return &WebhookDeliveryRepo{
+		Postgres: pg,
+		getter:   getter,
+	}
+}
+
+// Enqueue inserts pending deliveries due right away; only WebhookId,
+// EventId, EventType and Payload are used
+func (r *WebhookDeliveryRepo) Enqueue(
+	ctx context.Context,
+	deliveries ...domain.WebhookDelivery,
+) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	builder := r.Builder.
+		Insert("webhook_deliveries").
+		Columns("webhook_id", "event_id", "event_type", "payload", "status", "next_attempt_at", "created_at")
+	for _, d := range deliveries {
+		builder = builder.Values(d.WebhookId, d.EventId, string(d.EventType), d.Payload, string(domain.WebhookDeliveryPending), now, now)
+	}
+
+	sql, args, err := builder.ToSql()
+	if err != nil {
+		return fmt.Errorf("build insert webhook deliveries sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec insert webhook deliveries: %w", err)
+	}
+	return nil
+}
+
+// ClaimDue locks up to limit pending deliveries of active webhooks due at
+// now, skipping ones locked by other dispatchers, and postpones them to
+// leaseUntil so nobody else picks them up while they are being sent.
+// Must run in a transaction
+func (r *WebhookDeliveryRepo) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]domain.WebhookDispatch, error) {
+	sql, args, err := r.Builder.
+		Select(
+			"d.id", "d.webhook_id", "d.event_id", "d.event_type", "d.payload", "d.status",
+			"d.attempts", "d.next_attempt_at", "d.last_error", "d.created_at", "d.delivered_at",
+			"w.url", "w.secret",
+		).
+		From("webhook_deliveries d").
+		Join("webhooks w ON w.id = d.webhook_id").
+		Where(squirrel.Eq{"d.status": string(domain.WebhookDeliveryPending), "w.is_active": true}).
+		Where(squirrel.LtOrEq{"d.next_attempt_at": now}).
+		OrderBy("d.id").
+		Limit(uint64(limit)).
+		Suffix("FOR UPDATE OF d SKIP LOCKED").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select due webhook deliveries sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query due webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	dispatches := make([]domain.WebhookDispatch, 0)
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var dispatch domain.WebhookDispatch
+		var eventType, status string
+		d := &dispatch.Delivery
+		if err := rows.Scan(
+			&d.Id, &d.WebhookId, &d.EventId, &eventType, &d.Payload, &status,
+			&d.Attempts, &d.NextAttemptAt, &d.LastError, &d.CreatedAt, &d.DeliveredAt,
+			&dispatch.URL, &dispatch.Secret,
+		); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery row: %w", err)
+		}
+		d.EventType = domain.EventType(eventType)
+		d.Status = domain.WebhookDeliveryStatus(status)
+		dispatches = append(dispatches, dispatch)
+		ids = append(ids, d.Id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook delivery rows: %w", err)
+	}
+
+	if len(ids) == 0 {
+		return dispatches, nil
+	}
+
+	sql, args, err = r.Builder.
+		Update("webhook_deliveries").
+		Set("next_attempt_at", leaseUntil).
+		Where(squirrel.Eq{"id": ids}).
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build lease webhook deliveries sql: %w", err)
+	}
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return nil, fmt.Errorf("exec lease webhook deliveries: %w", err)
+	}
+
+	return dispatches, nil
+}
+
+func (r *WebhookDeliveryRepo) MarkDelivered(
+	ctx context.Context,
+	deliveryId int64,
+	attempts int,
+	deliveredAt time.Time,
+) error {
+	return r.update(ctx, deliveryId, map[string]any{
+		"status":       string(domain.WebhookDeliveryDelivered),
+		"attempts":     attempts,
+		"last_error":   "",
+		"delivered_at": deliveredAt,
+	})
+}
+
+// MarkRetry keeps the delivery pending until nextAttemptAt
+func (r *WebhookDeliveryRepo) MarkRetry(
+	ctx context.Context,
+	deliveryId int64,
+	attempts int,
+	lastError string,
+	nextAttemptAt time.Time,
+) error {
+	return r.update(ctx, deliveryId, map[string]any{
+		"attempts":        attempts,
+		"last_error":      lastError,
+		"next_attempt_at": nextAttemptAt,
+	})
+}
+
+// MarkFailed gives the delivery up
+func (r *WebhookDeliveryRepo) MarkFailed(
+	ctx context.Context,
+	deliveryId int64,
+	attempts int,
+	lastError string,
+) error {
+	return r.update(ctx, deliveryId, map[string]any{
+		"status":     string(domain.WebhookDeliveryFailed),
+		"attempts":   attempts,
+		"last_error": lastError,
+	})
+}
+
+func (r *WebhookDeliveryRepo) update(
+	ctx context.Context,
+	deliveryId int64,
+	values map[string]any,
+) error {
+	sql, args, err := r.Builder.
+		Update("webhook_deliveries").
+		SetMap(values).
+		Where(squirrel.Eq{"id": deliveryId}).
+		ToSql()
+	if err != nil {
+		return fmt.Errorf("build update webhook delivery sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	if _, err := conn.Exec(ctx, sql, args...); err != nil {
+		return fmt.Errorf("exec update webhook delivery: %w", err)
+	}
+	return nil
+}
+
+// ListByWebhookId returns the webhook's deliveries in the order they were queued
+func (r *WebhookDeliveryRepo) ListByWebhookId(
+	ctx context.Context,
+	webhookId uuid.UUID,
+) ([]domain.WebhookDelivery, error) {
+	sql, args, err := r.Builder.
+		Select(
+			"id", "webhook_id", "event_id", "event_type", "payload", "status",
+			"attempts", "next_attempt_at", "last_error", "created_at", "delivered_at",
+		).
+		From("webhook_deliveries").
+		Where(squirrel.Eq{"webhook_id": webhookId}).
+		OrderBy("id").
+		ToSql()
+	if err != nil {
+		return nil, fmt.Errorf("build select webhook deliveries sql: %w", err)
+	}
+
+	conn := r.getter.DefaultTrOrDB(ctx, r.Pool)
+
+	rows, err := conn.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, fmt.Errorf("query webhook deliveries: %w", err)
+	}
+	defer rows.Close()
+
+	deliveries := make([]domain.WebhookDelivery, 0)
+	for rows.Next() {
+		var d domain.WebhookDelivery
+		var eventType, status string
+		if err := rows.Scan(
+			&d.Id, &d.WebhookId, &d.EventId, &eventType, &d.Payload, &status,
+			&d.Attempts, &d.NextAttemptAt, &d.LastError, &d.CreatedAt, &d.DeliveredAt,
+		); err != nil {
+			return nil, fmt.Errorf("scan webhook delivery row: %w", err)
+		}
+		d.EventType = domain.EventType(eventType)
+		d.Status = domain.WebhookDeliveryStatus(status)
+		deliveries = append(deliveries, d)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate webhook delivery rows: %w", err)
+	}
+
+	return deliveries, nil
+}
@@ -5,6 +5,7 @@ import (
 	"avito-test-applicant/internal/repo/pgdb"
 	"avito-test-applicant/pkg/postgres"
 	"context"
+	"time"
 
 	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
 
@@ -16,6 +17,7 @@ type Team interface {
 		ctx context.Context,
 		teamId uuid.UUID,
 		teamName string,
+		reviewersRequired int,
 	) (domain.Team, error)
 	GetTeamByName(
 		ctx context.Context,
@@ -25,6 +27,25 @@ type Team interface {
 		ctx context.Context,
 		teamId uuid.UUID,
 	) (domain.Team, error)
+	SetReviewersRequired(
+		ctx context.Context,
+		teamId uuid.UUID,
+		reviewersRequired int,
+	) (domain.Team, error)
+	RenameTeam(
+		ctx context.Context,
+		teamId uuid.UUID,
+		teamName string,
+	) (domain.Team, error)
+	ListFallbackTeams(
+		ctx context.Context,
+		teamId uuid.UUID,
+	) ([]domain.Team, error)
+	ReplaceFallbackTeams(
+		ctx context.Context,
+		teamId uuid.UUID,
+		fallbackTeamIds []uuid.UUID,
+	) error
 }
 
 type User interface {
@@ -44,14 +65,36 @@ type User interface {
 		userId uuid.UUID,
 		isActive bool,
 	) (domain.User, error)
+	SetIsActiveByIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+		isActive bool,
+	) ([]domain.User, error)
 	GetUsersByTeam(
 		ctx context.Context,
 		teamId uuid.UUID,
 	) ([]domain.User, error)
+	GetUsersByIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) ([]domain.User, error)
+	GetActiveUsersByTeamIds(
+		ctx context.Context,
+		teamIds []uuid.UUID,
+	) ([]domain.User, error)
 	UpdateUser(
 		ctx context.Context,
 		user domain.User,
 	) (domain.User, error)
+	MoveToTeam(
+		ctx context.Context,
+		userId uuid.UUID,
+		teamId uuid.UUID,
+	) (domain.User, error)
+	RemoveFromTeam(
+		ctx context.Context,
+		userId uuid.UUID,
+	) (domain.User, error)
 }
 
 type PullRequest interface {
@@ -60,6 +103,7 @@ type PullRequest interface {
 		pullRequestId uuid.UUID,
 		pullRequestName string,
 		authorId uuid.UUID,
+		status domain.PullRequestStatus,
 	) (domain.PullRequest, error)
 	GetPullRequestById(
 		ctx context.Context,
@@ -73,6 +117,16 @@ type PullRequest interface {
 		ctx context.Context,
 		pullRequestId uuid.UUID,
 	) (domain.PullRequest, error)
+	SetStatus(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		from []domain.PullRequestStatus,
+		status domain.PullRequestStatus,
+	) (domain.PullRequest, error)
+	ListPullRequests(
+		ctx context.Context,
+		filter domain.PullRequestFilter,
+	) ([]domain.PullRequest, error)
 }
 
 type Reviewer interface {
@@ -86,14 +140,234 @@ type Reviewer interface {
 		pullRequestId uuid.UUID,
 		userId uuid.UUID,
 	) error
+	AssignMany(
+		ctx context.Context,
+		assignments []domain.ReviewAssignment,
+	) error
+	RemoveMany(
+		ctx context.Context,
+		assignments []domain.ReviewAssignment,
+	) error
 	ListReviewers(
 		ctx context.Context,
 		pullRequestId uuid.UUID,
 	) ([]uuid.UUID, error)
+	ListReviewersByPullRequestIds(
+		ctx context.Context,
+		pullRequestIds []uuid.UUID,
+	) (map[uuid.UUID][]uuid.UUID, error)
 	ListByUserId(
 		ctx context.Context,
 		userId uuid.UUID,
 	) ([]uuid.UUID, error)
+	ListOpenByUserId(
+		ctx context.Context,
+		userId uuid.UUID,
+	) ([]uuid.UUID, error)
+	ListOpenByUserIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) ([]domain.ReviewAssignment, error)
+	CountOpenByUserIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) (map[uuid.UUID]int, error)
+	LastAssignedAtByUserIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) (map[uuid.UUID]time.Time, error)
+	SetReviewState(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		userId uuid.UUID,
+		state domain.ReviewState,
+	) (domain.Review, error)
+	ListReviews(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) ([]domain.Review, error)
+	ListFallbackReviewers(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) ([]uuid.UUID, error)
+	ListReviewsByUserId(
+		ctx context.Context,
+		userId uuid.UUID,
+		state *domain.ReviewState,
+	) ([]domain.Review, error)
+}
+
+type TeamSettings interface {
+	GetByTeamId(
+		ctx context.Context,
+		teamId uuid.UUID,
+	) (domain.TeamSettings, error)
+	Upsert(
+		ctx context.Context,
+		settings domain.TeamSettings,
+	) (domain.TeamSettings, error)
+}
+
+type TeamMergePolicy interface {
+	GetByTeamId(
+		ctx context.Context,
+		teamId uuid.UUID,
+	) (domain.MergePolicy, error)
+	Upsert(
+		ctx context.Context,
+		policy domain.MergePolicy,
+	) (domain.MergePolicy, error)
+}
+
+type Stats interface {
+	GetStats(
+		ctx context.Context,
+		teamId *uuid.UUID,
+	) (domain.Stats, error)
+}
+
+type ReviewerEvent interface {
+	Append(
+		ctx context.Context,
+		events ...domain.ReviewerEvent,
+	) error
+	ListByPullRequestId(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) ([]domain.ReviewerEvent, error)
+}
+
+type MembershipEvent interface {
+	Append(
+		ctx context.Context,
+		events ...domain.MembershipEvent,
+	) error
+	ListByUserId(
+		ctx context.Context,
+		userId uuid.UUID,
+	) ([]domain.MembershipEvent, error)
+}
+
+type APIToken interface {
+	CreateToken(
+		ctx context.Context,
+		token domain.APIToken,
+		tokenHash []byte,
+	) (domain.APIToken, error)
+	GetByHash(
+		ctx context.Context,
+		tokenHash []byte,
+	) (domain.APIToken, error)
+	RevokeToken(
+		ctx context.Context,
+		tokenId uuid.UUID,
+	) (domain.APIToken, error)
+}
+
+type IdempotencyKey interface {
+	Reserve(
+		ctx context.Context,
+		record domain.IdempotencyRecord,
+	) (bool, error)
+	Get(
+		ctx context.Context,
+		scope string,
+		key string,
+	) (domain.IdempotencyRecord, error)
+	Complete(
+		ctx context.Context,
+		scope string,
+		key string,
+		statusCode int,
+		body []byte,
+	) error
+	Delete(
+		ctx context.Context,
+		scope string,
+		key string,
+	) error
+	DeleteExpired(
+		ctx context.Context,
+		now time.Time,
+	) (int64, error)
+}
+
+type Webhook interface {
+	CreateWebhook(
+		ctx context.Context,
+		webhook domain.Webhook,
+		secret string,
+	) (domain.Webhook, error)
+	GetWebhookById(
+		ctx context.Context,
+		webhookId uuid.UUID,
+	) (domain.Webhook, error)
+	ListWebhooks(
+		ctx context.Context,
+		onlyActive bool,
+	) ([]domain.Webhook, error)
+	UpdateWebhook(
+		ctx context.Context,
+		webhook domain.Webhook,
+	) (domain.Webhook, error)
+	DeleteWebhook(
+		ctx context.Context,
+		webhookId uuid.UUID,
+	) (domain.Webhook, error)
+}
+
+type WebhookDelivery interface {
+	Enqueue(
+		ctx context.Context,
+		deliveries ...domain.WebhookDelivery,
+	) error
+	ClaimDue(
+		ctx context.Context,
+		now time.Time,
+		leaseUntil time.Time,
+		limit int,
+	) ([]domain.WebhookDispatch, error)
+	MarkDelivered(
+		ctx context.Context,
+		deliveryId int64,
+		attempts int,
+		deliveredAt time.Time,
+	) error
+	MarkRetry(
+		ctx context.Context,
+		deliveryId int64,
+		attempts int,
+		lastError string,
+		nextAttemptAt time.Time,
+	) error
+	MarkFailed(
+		ctx context.Context,
+		deliveryId int64,
+		attempts int,
+		lastError string,
+	) error
+	ListByWebhookId(
+		ctx context.Context,
+		webhookId uuid.UUID,
+	) ([]domain.WebhookDelivery, error)
+}
+
+type Event interface {
+	Append(
+		ctx context.Context,
+		events ...domain.Event,
+	) error
+	Sequence(
+		ctx context.Context,
+	) (int64, error)
+	List(
+		ctx context.Context,
+		after *domain.EventCursor,
+		limit int,
+	) ([]domain.EventRecord, error)
+	LastCursor(
+		ctx context.Context,
+	) (*domain.EventCursor, error)
 }
 
 type Repositories struct {
@@ -101,13 +375,33 @@ type Repositories struct {
 	User
 	PullRequest
 	Reviewer
+	TeamSettings
+	TeamMergePolicy
+	Stats
+	ReviewerEvent
+	MembershipEvent
+	APIToken
+	IdempotencyKey
+	Webhook
+	WebhookDelivery
+	Event
 }
 
 func NewRepositories(pg *postgres.Postgres, getter *trmpgx.CtxGetter) *Repositories {
 	return &Repositories{
-		Team:        pgdb.NewTeamRepo(pg, getter),
-		User:        pgdb.NewUserRepo(pg, getter),
-		PullRequest: pgdb.NewPullRequestRepo(pg, getter),
-		Reviewer:    pgdb.NewReviewerRepo(pg, getter),
+		Team:            pgdb.NewTeamRepo(pg, getter),
+		User:            pgdb.NewUserRepo(pg, getter),
+		PullRequest:     pgdb.NewPullRequestRepo(pg, getter),
+		Reviewer:        pgdb.NewReviewerRepo(pg, getter),
+		TeamSettings:    pgdb.NewTeamSettingsRepo(pg, getter),
+		TeamMergePolicy: pgdb.NewTeamMergePolicyRepo(pg, getter),
+		Stats:           pgdb.NewStatsRepo(pg, getter),
+		ReviewerEvent:   pgdb.NewReviewerEventRepo(pg, getter),
+		MembershipEvent: pgdb.NewMembershipEventRepo(pg, getter),
+		APIToken:        pgdb.NewAPITokenRepo(pg, getter),
+		IdempotencyKey:  pgdb.NewIdempotencyKeyRepo(pg, getter),
+		Webhook:         pgdb.NewWebhookRepo(pg, getter),
+		WebhookDelivery: pgdb.NewWebhookDeliveryRepo(pg, getter),
+		Event:           pgdb.NewEventRepo(pg, getter),
 	}
 }
@@ -5,6 +5,7 @@ import "errors"
 var (
 	ErrNotFound      = errors.New("not found")
 	ErrAlreadyExists = errors.New("already exists")
+	ErrMerged        = errors.New("pull request is merged")
 
 	ErrNotEnoughBalance    = errors.New("not enough balance")
 	ErrUsernameTakenInTeam = errors.New("username already taken in team")
@@ -0,0 +1,222 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/internal/utils/id"
+	"avito-test-applicant/pkg/jwt"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// JWTVerifier checks a JWT signature and registered claims and decodes
+// the payload into claims
+type JWTVerifier interface {
+	Verify(ctx context.Context, token string, claims any) error
+}
+
+// jwtClaims SSO claims mapped onto the principal
+type jwtClaims struct {
+	Subject string   `json:"sub"`
+	Team    string   `json:"team"`
+	Roles   []string `json:"roles"`
+}
+
+type AuthService struct {
+	tokenRepo repo.APIToken
+	userRepo  repo.User
+	teamRepo  repo.Team
+	// verifier enables JWT mode, nil when not configured
+	verifier JWTVerifier
+}
+
+func NewAuthService(repos *repo.Repositories, verifier JWTVerifier) *AuthService {
+	return &AuthService{
+		tokenRepo: repos.APIToken,
+		userRepo:  repos.User,
+		teamRepo:  repos.Team,
+		verifier:  verifier,
+	}
+}
+
+func hashToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// Authenticate resolves a bearer token, either a JWT when JWT mode is on
+// or an API token; unknown and revoked tokens as well as tokens of
+// deactivated users are rejected
+func (s *AuthService) Authenticate(
+	ctx context.Context, token string,
+) (domain.Principal, error) {
+	if s.verifier != nil && jwt.IsJWT(token) {
+		return s.authenticateJWT(ctx, token)
+	}
+
+	t, err := s.tokenRepo.GetByHash(ctx, hashToken(token))
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.Principal{}, ErrUnauthorized
+		}
+		return domain.Principal{}, err
+	}
+	if t.RevokedAt != nil {
+		return domain.Principal{}, ErrUnauthorized
+	}
+
+	principal := domain.Principal{
+		TokenId: t.TokenId,
+		Role:    t.Role,
+		UserId:  t.UserId,
+	}
+	if t.UserId != nil {
+		user, err := s.activeUser(ctx, *t.UserId)
+		if err != nil {
+			return domain.Principal{}, err
+		}
+		principal.TeamId = &user.TeamId
+	}
+
+	return principal, nil
+}
+
+// authenticateJWT maps sub, team and roles claims: sub is the user id and
+// may be absent from the service only for admins, team overrides the
+// user's team
+func (s *AuthService) authenticateJWT(
+	ctx context.Context, token string,
+) (domain.Principal, error) {
+	var claims jwtClaims
+	if err := s.verifier.Verify(ctx, token, &claims); err != nil {
+		return domain.Principal{}, fmt.Errorf("%w: %v", ErrUnauthorized, err)
+	}
+
+	role, ok := domain.HighestRole(claims.Roles)
+	if !ok {
+		return domain.Principal{}, fmt.Errorf("%w: no known role in token", ErrUnauthorized)
+	}
+	principal := domain.Principal{
+		Subject: claims.Subject,
+		Role:    role,
+	}
+
+	if userId, err := uuid.Parse(claims.Subject); err == nil {
+		user, err := s.userRepo.GetUserById(ctx, userId)
+		switch {
+		case err == nil:
+			if !user.IsActive {
+				return domain.Principal{}, ErrUnauthorized
+			}
+			principal.UserId = &user.UserId
+			principal.TeamId = &user.TeamId
+		case !errors.Is(err, repoerrors.ErrNotFound):
+			return domain.Principal{}, err
+		}
+	}
+	if principal.UserId == nil && role != domain.RoleAdmin {
+		return domain.Principal{}, fmt.Errorf("%w: sub is not a known user", ErrUnauthorized)
+	}
+
+	if claims.Team != "" {
+		team, err := s.teamRepo.GetTeamByName(ctx, claims.Team)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return domain.Principal{}, fmt.Errorf("%w: unknown team %q", ErrUnauthorized, claims.Team)
+			}
+			return domain.Principal{}, err
+		}
+		principal.TeamId = &team.TeamId
+	}
+
+	return principal, nil
+}
+
+func (s *AuthService) activeUser(ctx context.Context, userId uuid.UUID) (domain.User, error) {
+	user, err := s.userRepo.GetUserById(ctx, userId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.User{}, ErrUnauthorized
+		}
+		return domain.User{}, err
+	}
+	if !user.IsActive {
+		return domain.User{}, ErrUnauthorized
+	}
+	return user, nil
+}
+
+// IssueToken creates a token for the role; the token value is returned
+// only here, later it is known by its hash
+func (s *AuthService) IssueToken(
+	ctx context.Context, role domain.Role, userId *uuid.UUID,
+) (string, domain.APIToken, error) {
+	switch role {
+	case domain.RoleAdmin:
+	case domain.RoleTeamLead, domain.RoleUser:
+		if userId == nil {
+			return "", domain.APIToken{}, fmt.Errorf("%w: role %s needs a user", ErrInvalidToken, role)
+		}
+	default:
+		return "", domain.APIToken{}, fmt.Errorf("%w: unknown role %q", ErrInvalidToken, role)
+	}
+
+	if userId != nil {
+		if _, err := s.userRepo.GetUserById(ctx, *userId); err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return "", domain.APIToken{}, ErrUserNotFound
+			}
+			return "", domain.APIToken{}, err
+		}
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.APIToken{}, fmt.Errorf("generate token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	created, err := s.tokenRepo.CreateToken(ctx, domain.APIToken{
+		TokenId: id.NewUUID(),
+		Role:    role,
+		UserId:  userId,
+	}, hashToken(token))
+	if err != nil {
+		return "", domain.APIToken{}, err
+	}
+
+	return token, created, nil
+}
+
+func (s *AuthService) RevokeToken(
+	ctx context.Context, tokenId uuid.UUID,
+) (domain.APIToken, error) {
+	token, err := s.tokenRepo.RevokeToken(ctx, tokenId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.APIToken{}, ErrNotFound
+		}
+		return domain.APIToken{}, err
+	}
+	return token, nil
+}
+
+// EnsureAdminToken registers a preconfigured admin token, e.g. the one
+// from the config used to issue the first real tokens
+func (s *AuthService) EnsureAdminToken(ctx context.Context, token string) error {
+	_, err := s.tokenRepo.CreateToken(ctx, domain.APIToken{
+		TokenId: id.NewUUID(),
+		Role:    domain.RoleAdmin,
+	}, hashToken(token))
+	if err != nil && !errors.Is(err, repoerrors.ErrAlreadyExists) {
+		return err
+	}
+	return nil
+}
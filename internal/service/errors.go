@@ -1,10 +1,17 @@
 package service
 
-import "errors"
+import (
+	"errors"
+
+	"avito-test-applicant/internal/domain"
+)
 
 var (
 	ErrNotFound          = errors.New("entity not found")
 	ErrTeamAlreadyExists = errors.New("team already exists")
+	ErrUserAlreadyExists = errors.New("user already exists")
+	ErrUsernameTaken     = errors.New("username is already taken in the team")
+	ErrUserInOtherTeam   = errors.New("users already belong to another team")
 
 	ErrAuthorNotFound          = errors.New("author not found")
 	ErrPullRequestExists       = errors.New("pull request already exists")
@@ -15,4 +22,40 @@ var (
 	ErrUserNotFound            = errors.New("user not found")
 	ErrNotAssigned             = errors.New("reviewer is not assigned to this PR")
 	ErrNoCandidate             = errors.New("no candidates available for review assignment")
+	ErrUserNotInTeam           = errors.New("user is not a member of the team")
+	ErrAlreadyAssigned         = errors.New("reviewer is already assigned to this PR")
+	ErrTooManyReviewers        = errors.New("pull request already has the maximum number of reviewers")
+	ErrInvalidReviewer         = errors.New("user cannot review this pull request")
+
+	ErrUnknownSelectionStrategy = errors.New("unknown reviewer selection strategy")
+	ErrInvalidReviewersRequired = errors.New("reviewers_required must be at least 1")
+	ErrReviewerCountChanged     = errors.New("reassignment changed the number of assigned reviewers")
+	ErrInvalidFallbackTeam      = errors.New("fallback teams must be distinct and differ from the team itself")
+	ErrInvalidReviewState       = errors.New("review verdict must be APPROVED or CHANGES_REQUESTED")
+	ErrInvalidMergePolicy       = errors.New("required_approvals must be non-negative and lead approval needs a lead")
+	ErrNotApproved              = errors.New("pull request does not satisfy the team merge policy")
+	ErrInvalidStatusTransition  = errors.New("pull request status transition is not allowed")
+	ErrPullRequestNotOpen       = errors.New("pull request is not open")
+
+	ErrUnauthorized = errors.New("missing, unknown or revoked api token")
+	ErrInvalidToken = errors.New("invalid token request")
+
+	ErrIdempotencyKeyInProgress = errors.New("a request with this idempotency key is still in progress")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key was already used for a different request")
+
+	ErrInvalidWebhook = errors.New("invalid webhook")
 )
+
+// UsersInOtherTeamError lists existing users a new team would take over;
+// it matches ErrUserInOtherTeam with errors.Is
+type UsersInOtherTeamError struct {
+	Users []domain.UserWithTeamName
+}
+
+func (e *UsersInOtherTeamError) Error() string {
+	return ErrUserInOtherTeam.Error()
+}
+
+func (e *UsersInOtherTeamError) Unwrap() error {
+	return ErrUserInOtherTeam
+}
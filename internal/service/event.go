@@ -0,0 +1,76 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+)
+
+type EventService struct {
+	eventRepo repo.Event
+	trManager postgres.TransactionManager
+}
+
+func NewEventService(
+	repos *repo.Repositories,
+	trManager *postgres.TransactionManager,
+) *EventService {
+	return &EventService{
+		eventRepo: repos.Event,
+		trManager: *trManager,
+	}
+}
+
+// sequence numbers events committed since the last call; when another
+// reader is numbering them right now, their events show up on the next read
+func (s *EventService) sequence(ctx context.Context) error {
+	return s.trManager.Do(ctx, func(ctx context.Context) error {
+		_, err := s.eventRepo.Sequence(ctx)
+		return err
+	})
+}
+
+// ListEvents returns committed events after the cursor in commit order
+func (s *EventService) ListEvents(
+	ctx context.Context, after *domain.EventCursor, limit int,
+) (domain.EventPage, error) {
+	if err := s.sequence(ctx); err != nil {
+		return domain.EventPage{}, err
+	}
+
+	if limit <= 0 {
+		limit = domain.DefaultPageLimit
+	}
+	if limit > domain.MaxPageLimit {
+		limit = domain.MaxPageLimit
+	}
+
+	// one extra row tells whether more events are available
+	events, err := s.eventRepo.List(ctx, after, limit+1)
+	if err != nil {
+		return domain.EventPage{}, err
+	}
+
+	page := domain.EventPage{NextCursor: after}
+	if len(events) > limit {
+		events = events[:limit]
+		page.HasMore = true
+	}
+	if len(events) > 0 {
+		page.NextCursor = &events[len(events)-1].Cursor
+	}
+	page.Events = events
+	return page, nil
+}
+
+// LastCursor position a consumer interested only in new events starts from,
+// nil while there are no events
+func (s *EventService) LastCursor(
+	ctx context.Context,
+) (*domain.EventCursor, error) {
+	if err := s.sequence(ctx); err != nil {
+		return nil, err
+	}
+	return s.eventRepo.LastCursor(ctx)
+}
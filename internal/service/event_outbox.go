@@ -0,0 +1,166 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/utils/id"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// eventOutbox appends events to the feed and queues one delivery per
+// subscribed active webhook; it must run in the transaction that makes the
+// change, so an event is visible exactly when the change is committed
+type eventOutbox struct {
+	eventRepo    repo.Event
+	webhookRepo  repo.Webhook
+	deliveryRepo repo.WebhookDelivery
+}
+
+func newEventOutbox(repos *repo.Repositories) eventOutbox {
+	return eventOutbox{
+		eventRepo:    repos.Event,
+		webhookRepo:  repos.Webhook,
+		deliveryRepo: repos.WebhookDelivery,
+	}
+}
+
+// enqueue stamps id, version, time and the request's actor on the events
+func (o eventOutbox) enqueue(
+	ctx context.Context,
+	events ...domain.Event,
+) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	actor := domain.ActorFromContext(ctx)
+	now := time.Now().UTC()
+	for i := range events {
+		events[i].Id = id.NewUUID()
+		events[i].Version = domain.EventVersion
+		events[i].OccurredAt = now
+		if events[i].Data.ActorId == nil {
+			events[i].Data.ActorId = actor
+		}
+	}
+
+	if err := o.eventRepo.Append(ctx, events...); err != nil {
+		return err
+	}
+
+	webhooks, err := o.webhookRepo.ListWebhooks(ctx, true)
+	if err != nil {
+		return err
+	}
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	deliveries := make([]domain.WebhookDelivery, 0, len(events)*len(webhooks))
+	for _, e := range events {
+		var payload []byte
+		for _, w := range webhooks {
+			if !w.Subscribed(e.Type) {
+				continue
+			}
+			if payload == nil {
+				payload, err = json.Marshal(e)
+				if err != nil {
+					return fmt.Errorf("marshal event: %w", err)
+				}
+			}
+			deliveries = append(deliveries, domain.WebhookDelivery{
+				WebhookId: w.WebhookId,
+				EventId:   e.Id,
+				EventType: e.Type,
+				Payload:   payload,
+			})
+		}
+	}
+
+	return o.deliveryRepo.Enqueue(ctx, deliveries...)
+}
+
+func pullRequestCreatedEvent(pr domain.PullRequest) domain.Event {
+	return domain.Event{
+		Type: domain.EventPullRequestCreated,
+		Data: domain.EventData{
+			PullRequestId:   &pr.PullRequestId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        &pr.AuthorId,
+			Status:          &pr.Status,
+		},
+	}
+}
+
+func pullRequestStatusChangedEvent(
+	pr domain.PullRequest, previous domain.PullRequestStatus,
+) domain.Event {
+	return domain.Event{
+		Type: domain.EventPullRequestStatusChanged,
+		Data: domain.EventData{
+			PullRequestId:  &pr.PullRequestId,
+			Status:         &pr.Status,
+			PreviousStatus: &previous,
+		},
+	}
+}
+
+func reviewSubmittedEvent(
+	pullRequestId uuid.UUID, reviewerId uuid.UUID, state domain.ReviewState,
+) domain.Event {
+	return domain.Event{
+		Type: domain.EventReviewSubmitted,
+		Data: domain.EventData{
+			PullRequestId: &pullRequestId,
+			UserId:        &reviewerId,
+			ReviewState:   &state,
+		},
+	}
+}
+
+func userActivityEvent(userId uuid.UUID, isActive bool) domain.Event {
+	eventType := domain.EventUserDeactivated
+	if isActive {
+		eventType = domain.EventUserActivated
+	}
+	return domain.Event{
+		Type: eventType,
+		Data: domain.EventData{UserId: &userId},
+	}
+}
+
+// fromReviewerEvents maps history entries to events: a handover is an
+// unassignment of the old reviewer and an assignment of the new one
+func fromReviewerEvents(events []domain.ReviewerEvent) []domain.Event {
+	out := make([]domain.Event, 0, len(events))
+	for _, e := range events {
+		data := domain.EventData{
+			PullRequestId: &e.PullRequestId,
+			UserId:        e.UserId,
+			ActorId:       e.ActorId,
+			Reason:        e.Reason,
+		}
+		switch e.Type {
+		case domain.ReviewerEventAssigned:
+			out = append(out, domain.Event{Type: domain.EventReviewerAssigned, Data: data})
+		case domain.ReviewerEventUnassigned:
+			out = append(out, domain.Event{Type: domain.EventReviewerUnassigned, Data: data})
+		case domain.ReviewerEventReassigned, domain.ReviewerEventDeactivated:
+			out = append(out, domain.Event{Type: domain.EventReviewerUnassigned, Data: data})
+			if e.ReplacedBy != nil {
+				data.UserId = e.ReplacedBy
+				out = append(out, domain.Event{Type: domain.EventReviewerAssigned, Data: data})
+			}
+		case domain.ReviewerEventMerged:
+			data.UserId = nil
+			out = append(out, domain.Event{Type: domain.EventPullRequestMerged, Data: data})
+		}
+	}
+	return out
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"bytes"
+	"context"
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultIdempotencyTTL how long a stored response is replayed when no ttl is configured
+	DefaultIdempotencyTTL = 24 * time.Hour
+	// DefaultIdempotencyLease how long a request may hold its key when no lease is configured
+	DefaultIdempotencyLease = time.Minute
+)
+
+type IdempotencyService struct {
+	keyRepo repo.IdempotencyKey
+	ttl     time.Duration
+	lease   time.Duration
+}
+
+func NewIdempotencyService(
+	repos *repo.Repositories, ttl time.Duration, lease time.Duration,
+) *IdempotencyService {
+	if ttl <= 0 {
+		ttl = DefaultIdempotencyTTL
+	}
+	if lease <= 0 {
+		lease = DefaultIdempotencyLease
+	}
+	return &IdempotencyService{
+		keyRepo: repos.IdempotencyKey,
+		ttl:     ttl,
+		lease:   lease,
+	}
+}
+
+// Begin reserves the key for a new request and returns nil, or returns the
+// stored response of an earlier request with the same key and payload.
+// A key still being served gives ErrIdempotencyKeyInProgress, a key used
+// for another payload ErrIdempotencyKeyReused. A reservation is held for the
+// lease only, so a request that died before Complete or Release does not
+// block the key; expired records are reserved again as well
+func (s *IdempotencyService) Begin(
+	ctx context.Context, scope string, key string, requestHash []byte,
+) (*domain.IdempotencyRecord, error) {
+	now := time.Now().UTC()
+	lockedUntil := now.Add(s.lease)
+
+	reserved, err := s.keyRepo.Reserve(ctx, domain.IdempotencyRecord{
+		Scope:       scope,
+		Key:         key,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(s.ttl),
+		LockedUntil: &lockedUntil,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	stored, err := s.keyRepo.Get(ctx, scope, key)
+	if err != nil {
+		// released by a failed request in between
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, ErrIdempotencyKeyInProgress
+		}
+		return nil, err
+	}
+	if !bytes.Equal(stored.RequestHash, requestHash) {
+		return nil, ErrIdempotencyKeyReused
+	}
+	if stored.StatusCode == nil {
+		return nil, ErrIdempotencyKeyInProgress
+	}
+	return &stored, nil
+}
+
+// Complete stores the response replayed for later requests with the key
+func (s *IdempotencyService) Complete(
+	ctx context.Context, scope string, key string, statusCode int, body []byte,
+) error {
+	return s.keyRepo.Complete(ctx, scope, key, statusCode, body)
+}
+
+// Release frees the key so the request can be retried, used when it failed
+func (s *IdempotencyService) Release(
+	ctx context.Context, scope string, key string,
+) error {
+	return s.keyRepo.Delete(ctx, scope, key)
+}
+
+// DeleteExpired removes records whose ttl ran out, run periodically to keep
+// the table small
+func (s *IdempotencyService) DeleteExpired(ctx context.Context) (int64, error) {
+	return s.keyRepo.DeleteExpired(ctx, time.Now().UTC())
+}
@@ -0,0 +1,35 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// recordMembershipEvent appends a team change of the user on behalf of the
+// request's actor; uuid.Nil stands for "no team" on either side
+func recordMembershipEvent(
+	ctx context.Context,
+	eventRepo repo.MembershipEvent,
+	userId uuid.UUID,
+	fromTeamId uuid.UUID,
+	toTeamId uuid.UUID,
+	reason string,
+) error {
+	return eventRepo.Append(ctx, domain.MembershipEvent{
+		UserId:     userId,
+		FromTeamId: optionalTeamId(fromTeamId),
+		ToTeamId:   optionalTeamId(toTeamId),
+		ActorId:    domain.ActorFromContext(ctx),
+		Reason:     reason,
+	})
+}
+
+func optionalTeamId(teamId uuid.UUID) *uuid.UUID {
+	if teamId == uuid.Nil {
+		return nil
+	}
+	return &teamId
+}
@@ -7,107 +7,78 @@ import (
 	"avito-test-applicant/pkg/postgres"
 	"context"
 	"errors"
-	"math/rand"
-	"time"
+	"fmt"
+	"slices"
 
 	"github.com/google/uuid"
 )
 
 type PullRequestService struct {
-	pullRequestRepo repo.PullRequest
-	reviewerRepo    repo.Reviewer
-	userRepo        repo.User
-	trManager       postgres.TransactionManager
+	pullRequestRepo  repo.PullRequest
+	reviewerRepo     repo.Reviewer
+	userRepo         repo.User
+	teamRepo         repo.Team
+	teamSettingsRepo repo.TeamSettings
+	mergePolicyRepo  repo.TeamMergePolicy
+	eventRepo        repo.ReviewerEvent
+	outbox           eventOutbox
+	trManager        postgres.TransactionManager
+	picker           reviewerPicker
 }
 
-func NewPullRequestService(repos *repo.Repositories, trManager *postgres.TransactionManager) *PullRequestService {
+func NewPullRequestService(
+	repos *repo.Repositories,
+	trManager *postgres.TransactionManager,
+	selectors ReviewerSelectors,
+	defaultStrategy domain.SelectionStrategy,
+	randSource RandSource,
+) *PullRequestService {
 	return &PullRequestService{
-		pullRequestRepo: repos.PullRequest,
-		reviewerRepo:    repos.Reviewer,
-		userRepo:        repos.User,
-		trManager:       *trManager,
+		pullRequestRepo:  repos.PullRequest,
+		reviewerRepo:     repos.Reviewer,
+		userRepo:         repos.User,
+		teamRepo:         repos.Team,
+		teamSettingsRepo: repos.TeamSettings,
+		mergePolicyRepo:  repos.TeamMergePolicy,
+		eventRepo:        repos.ReviewerEvent,
+		outbox:           newEventOutbox(repos),
+		trManager:        *trManager,
+		picker:           newReviewerPicker(repos, selectors, defaultStrategy, randSource),
 	}
 }
 
-func (s *PullRequestService) selectFromTeamExcludeAuthor(
-	ctx context.Context,
-	teamId uuid.UUID,
-	authorId uuid.UUID,
-	n int,
-) ([]uuid.UUID, error) {
-	users, err := s.userRepo.GetUsersByTeam(ctx, teamId)
-	if err != nil {
-		return nil, err
-	}
-
-	// 1) collect active non-author users
-	candidates := make([]uuid.UUID, 0, len(users))
-	for _, u := range users {
-		if u.UserId == authorId {
-			continue
-		}
-		if !u.IsActive {
-			continue
-		}
-		candidates = append(candidates, u.UserId)
-	}
-	if len(candidates) == 0 {
-		return []uuid.UUID{}, nil
-	}
-
-	// 2) shuffle
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(candidates), func(i, j int) {
-		candidates[i], candidates[j] = candidates[j], candidates[i]
-	})
-
-	// 3) take first n (or fewer)
-	if len(candidates) > n {
-		candidates = candidates[:n]
-	}
-	return candidates, nil
-}
-
 func (s *PullRequestService) selectReplacement(
 	ctx context.Context,
+	pullRequestId uuid.UUID,
 	teamId uuid.UUID,
 	authorId uuid.UUID,
 	assigned []uuid.UUID,
 	oldUserId uuid.UUID,
 ) (uuid.UUID, error) {
-	users, err := s.userRepo.GetUsersByTeam(ctx, teamId)
-	if err != nil {
-		return uuid.Nil, err
-	}
-
-	assignedSet := make(map[uuid.UUID]struct{}, len(assigned))
+	excluded := make(map[uuid.UUID]struct{}, len(assigned)+2)
+	excluded[authorId] = struct{}{}
+	excluded[oldUserId] = struct{}{}
 	for _, id := range assigned {
-		assignedSet[id] = struct{}{}
+		excluded[id] = struct{}{}
 	}
 
-	candidates := make([]uuid.UUID, 0, len(users))
-	for _, u := range users {
-		if u.UserId == authorId {
-			continue
-		}
-		if u.UserId == oldUserId {
-			continue
-		}
-		if !u.IsActive {
-			continue
-		}
-		if _, exists := assignedSet[u.UserId]; exists {
-			continue
-		}
-		candidates = append(candidates, u.UserId)
+	selected, err := s.picker.selectReviewers(ctx, pullRequestId, teamId, excluded, 1)
+	if err != nil {
+		return uuid.Nil, err
 	}
-
-	if len(candidates) == 0 {
+	if len(selected) == 0 {
 		return uuid.Nil, ErrNoCandidate
 	}
+	return selected[0], nil
+}
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	return candidates[r.Intn(len(candidates))], nil
+// fallbackReviewers returns reviewers that were outside the author's team
+// when assigned; later team moves of either side do not change it
+func (s *PullRequestService) fallbackReviewers(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+) ([]uuid.UUID, error) {
+	return s.reviewerRepo.ListFallbackReviewers(ctx, pullRequestId)
 }
 
 func (s *PullRequestService) assignReviewers(
@@ -123,8 +94,45 @@ func (s *PullRequestService) assignReviewers(
 	return nil
 }
 
+// assignInitialReviewers selects and assigns up to reviewers_required
+// reviewers of the author's team, reason goes to the history
+func (s *PullRequestService) assignInitialReviewers(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	author domain.User,
+	reason string,
+) ([]uuid.UUID, error) {
+	// author removed from their team: nobody to pick from
+	if author.TeamId == uuid.Nil {
+		return []uuid.UUID{}, nil
+	}
+	team, err := s.teamRepo.GetTeamById(ctx, author.TeamId)
+	if err != nil {
+		return nil, err
+	}
+	excluded := map[uuid.UUID]struct{}{author.UserId: {}}
+	reviewers, err := s.picker.selectReviewers(ctx, pullRequestId, team.TeamId, excluded, team.ReviewersRequired)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(reviewers) > 0 {
+		if err := s.assignReviewers(ctx, pullRequestId, reviewers); err != nil {
+			return nil, err
+		}
+	}
+
+	events := assignedEvents(pullRequestId, reviewers, reason)
+	if err := recordReviewerEvents(ctx, s.eventRepo, s.outbox, events...); err != nil {
+		return nil, err
+	}
+	return reviewers, nil
+}
+
+// CreateAndAssignPullRequest creates the PR; reviewers of a draft are
+// assigned only once it is marked ready
 func (s *PullRequestService) CreateAndAssignPullRequest(
-	ctx context.Context, pullRequestId uuid.UUID, pullRequestName string, authorId uuid.UUID,
+	ctx context.Context, pullRequestId uuid.UUID, pullRequestName string, authorId uuid.UUID, draft bool,
 ) (domain.PullRequestWithReviewers, error) {
 	var result domain.PullRequestWithReviewers
 
@@ -138,9 +146,17 @@ func (s *PullRequestService) CreateAndAssignPullRequest(
 			}
 			return err
 		}
+		// users removed from their team cannot open new PRs
+		if author.TeamId == uuid.Nil {
+			return ErrAuthorNotFound
+		}
 
 		// 2) create PR
-		pr, err := s.pullRequestRepo.CreatePullRequest(ctx, pullRequestId, pullRequestName, authorId)
+		status := domain.PullRequestStatusOPEN
+		if draft {
+			status = domain.PullRequestStatusDRAFT
+		}
+		pr, err := s.pullRequestRepo.CreatePullRequest(ctx, pullRequestId, pullRequestName, authorId, status)
 		if err != nil {
 			// PR exists -> bubble up as already exists
 			if errors.Is(err, repoerrors.ErrAlreadyExists) {
@@ -148,22 +164,34 @@ func (s *PullRequestService) CreateAndAssignPullRequest(
 			}
 			return err
 		}
-		// 3) select up to 2 reviewers
-		reviewers, err := s.selectFromTeamExcludeAuthor(ctx, author.TeamId, authorId, 2)
-		if err != nil {
+		if err := s.outbox.enqueue(ctx, pullRequestCreatedEvent(pr)); err != nil {
 			return err
 		}
 
-		// 4) assign reviewers
-		if len(reviewers) > 0 {
-			if err := s.assignReviewers(ctx, pr.PullRequestId, reviewers); err != nil {
+		// 3-4) select and assign reviewers unless it is a draft
+		reviewers := []uuid.UUID{}
+		if !draft {
+			reviewers, err = s.assignInitialReviewers(ctx, pr.PullRequestId, author, "pull request created")
+			if err != nil {
 				return err
 			}
 		}
 
 		// 5) prepare result
+		fallback, err := s.fallbackReviewers(ctx, pr.PullRequestId)
+		if err != nil {
+			return err
+		}
+
+		reviews, err := s.reviewerRepo.ListReviews(ctx, pr.PullRequestId)
+		if err != nil {
+			return err
+		}
+
 		result.PullRequest = pr
 		result.Reviewers = reviewers
+		result.FallbackReviewers = fallback
+		result.Reviews = reviews
 		return nil
 	})
 
@@ -174,24 +202,155 @@ func (s *PullRequestService) CreateAndAssignPullRequest(
 	return result, nil
 }
 
+// withReviews loads reviewers of the PR together with their review states
+func (s *PullRequestService) withReviews(
+	ctx context.Context, pr domain.PullRequest,
+) (domain.PullRequestWithReviewers, error) {
+	reviews, err := s.reviewerRepo.ListReviews(ctx, pr.PullRequestId)
+	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+
+	reviewers := make([]uuid.UUID, len(reviews))
+	for i, r := range reviews {
+		reviewers[i] = r.UserId
+	}
+
+	fallback, err := s.fallbackReviewers(ctx, pr.PullRequestId)
+	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+
+	return domain.PullRequestWithReviewers{
+		PullRequest:       pr,
+		Reviewers:         reviewers,
+		FallbackReviewers: fallback,
+		Reviews:           reviews,
+	}, nil
+}
+
 func (s *PullRequestService) GetPullRequestById(
 	ctx context.Context, pullRequestId uuid.UUID,
-) (domain.PullRequest, error) {
+) (domain.PullRequestWithReviewers, error) {
 	pr, err := s.pullRequestRepo.GetPullRequestById(ctx, pullRequestId)
 	if err != nil {
 		if errors.Is(err, repoerrors.ErrNotFound) {
-			return domain.PullRequest{}, ErrNotFound
+			return domain.PullRequestWithReviewers{}, ErrNotFound
 		}
-		return domain.PullRequest{}, err
+		return domain.PullRequestWithReviewers{}, err
 	}
 
-	return pr, nil
+	return s.withReviews(ctx, pr)
 }
 
+// ListPullRequests returns one page of PRs; teamName, when set, filters by
+// the author's team
+func (s *PullRequestService) ListPullRequests(
+	ctx context.Context,
+	teamName *string,
+	filter domain.PullRequestFilter,
+) (domain.PullRequestPage, error) {
+	if teamName != nil {
+		team, err := s.teamRepo.GetTeamByName(ctx, *teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return domain.PullRequestPage{}, ErrNotFound
+			}
+			return domain.PullRequestPage{}, err
+		}
+		filter.TeamId = &team.TeamId
+	}
+
+	limit := filter.Limit
+	if limit <= 0 {
+		limit = domain.DefaultPageLimit
+	}
+	if limit > domain.MaxPageLimit {
+		limit = domain.MaxPageLimit
+	}
+	// one extra row tells whether there is a next page
+	filter.Limit = limit + 1
+
+	prs, err := s.pullRequestRepo.ListPullRequests(ctx, filter)
+	if err != nil {
+		return domain.PullRequestPage{}, err
+	}
+
+	var page domain.PullRequestPage
+	if len(prs) > limit {
+		prs = prs[:limit]
+		last := prs[len(prs)-1]
+		page.NextCursor = &domain.PullRequestCursor{
+			CreatedAt:     *last.CreatedAt,
+			PullRequestId: last.PullRequestId,
+		}
+	}
+
+	page.PullRequests = make([]domain.PullRequestShort, len(prs))
+	for i, pr := range prs {
+		page.PullRequests[i] = domain.PullRequestShort{
+			PullRequestId:   pr.PullRequestId,
+			AuthorId:        pr.AuthorId,
+			PullRequestName: pr.PullRequestName,
+			Status:          pr.Status,
+		}
+	}
+
+	return page, nil
+}
+
+// checkMergePolicy verifies approvals against the policy of the author's team
+func (s *PullRequestService) checkMergePolicy(
+	ctx context.Context, pr domain.PullRequest,
+) error {
+	author, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
+	if err != nil {
+		return err
+	}
+
+	policy, err := s.mergePolicyRepo.GetByTeamId(ctx, author.TeamId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+
+	reviews, err := s.reviewerRepo.ListReviews(ctx, pr.PullRequestId)
+	if err != nil {
+		return err
+	}
+
+	approvals := 0
+	leadApproved := false
+	for _, r := range reviews {
+		if r.State != domain.ReviewStateApproved {
+			continue
+		}
+		approvals++
+		if policy.LeadUserId != nil && r.UserId == *policy.LeadUserId {
+			leadApproved = true
+		}
+	}
+
+	if approvals < policy.RequiredApprovals {
+		return fmt.Errorf("%w: %d of %d approvals", ErrNotApproved, approvals, policy.RequiredApprovals)
+	}
+	// the lead cannot approve their own PR
+	leadIsAuthor := policy.LeadUserId != nil && *policy.LeadUserId == pr.AuthorId
+	if policy.RequireLeadApproval && !leadIsAuthor && !leadApproved {
+		return fmt.Errorf("%w: team lead has not approved", ErrNotApproved)
+	}
+
+	return nil
+}
+
+// SetMerged merges the PR once the team merge policy is satisfied;
+// force skips the policy check
 func (s *PullRequestService) SetMerged(
-	ctx context.Context, pullRequestId uuid.UUID,
-) (domain.PullRequest, error) {
-	var pr domain.PullRequest
+	ctx context.Context, pullRequestId uuid.UUID, force bool,
+) (domain.PullRequestWithReviewers, error) {
+	var result domain.PullRequestWithReviewers
 
 	err := s.trManager.Do(ctx, func(ctx context.Context) error {
 		// 1) get current PR
@@ -204,100 +363,507 @@ func (s *PullRequestService) SetMerged(
 		}
 
 		// 2) if already merged — idempotent, return current state
-		if current.Status == domain.PullRequestStatusMERGED {
-			pr = current
-			return nil
+		pr := current
+		if current.Status != domain.PullRequestStatusMERGED {
+			// only OPEN PRs can be merged
+			if err := transitionMerge.check(current.Status); err != nil {
+				return err
+			}
+
+			// 3) check approvals required by the team
+			if !force {
+				if err := s.checkMergePolicy(ctx, current); err != nil {
+					return err
+				}
+			}
+
+			// 4) otherwise set merged and take updated row
+			pr, err = s.pullRequestRepo.SetMerged(ctx, pullRequestId)
+			if err != nil {
+				// the PR was closed concurrently
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, current.Status, transitionMerge.To)
+				}
+				return err
+			}
+
+			reason := "merged"
+			if force {
+				reason = "merged with force, merge policy skipped"
+			}
+			err = recordReviewerEvents(ctx, s.eventRepo, s.outbox, domain.ReviewerEvent{
+				PullRequestId: pullRequestId,
+				Type:          domain.ReviewerEventMerged,
+				Reason:        reason,
+			})
+			if err != nil {
+				return err
+			}
 		}
 
-		// 3) otherwise set merged and return updated row
-		updated, err := s.pullRequestRepo.SetMerged(ctx, pullRequestId)
+		// 5) reviewers are frozen from now on, return them as they are
+		result, err = s.withReviews(ctx, pr)
+		return err
+	})
+
+	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+	return result, nil
+}
+
+// changeStatus applies the transition; a PR already in the target status is
+// returned as is. A PR that becomes OPEN without reviewers gets them assigned
+func (s *PullRequestService) changeStatus(
+	ctx context.Context, pullRequestId uuid.UUID, transition statusTransition,
+) (domain.PullRequestWithReviewers, error) {
+	var result domain.PullRequestWithReviewers
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		// 1) get current PR
+		current, err := s.pullRequestRepo.GetPullRequestById(ctx, pullRequestId)
 		if err != nil {
 			if errors.Is(err, repoerrors.ErrNotFound) {
-				return ErrNotFound
+				return ErrPullRequestNotFound
 			}
 			return err
 		}
 
-		pr = updated
-		return nil
+		pr := current
+		if current.Status != transition.To {
+			// 2) reject illegal transitions
+			if err := transition.check(current.Status); err != nil {
+				return err
+			}
+
+			// 3) change status unless it was changed concurrently
+			pr, err = s.pullRequestRepo.SetStatus(ctx, pullRequestId, transition.From, transition.To)
+			if err != nil {
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, current.Status, transition.To)
+				}
+				return err
+			}
+			if err := s.outbox.enqueue(ctx, pullRequestStatusChangedEvent(pr, current.Status)); err != nil {
+				return err
+			}
+
+			// 4) assign reviewers deferred while the PR was a draft
+			if pr.Status == domain.PullRequestStatusOPEN {
+				assigned, err := s.reviewerRepo.ListReviewers(ctx, pullRequestId)
+				if err != nil {
+					return err
+				}
+				if len(assigned) == 0 {
+					author, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
+					if err != nil {
+						return err
+					}
+					reason := fmt.Sprintf("status changed %s -> %s", current.Status, pr.Status)
+					if _, err := s.assignInitialReviewers(ctx, pullRequestId, author, reason); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
+		// 5) prepare result
+		result, err = s.withReviews(ctx, pr)
+		return err
 	})
 
 	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+	return result, nil
+}
+
+// ClosePullRequest declines a DRAFT or OPEN PR without merging it
+func (s *PullRequestService) ClosePullRequest(
+	ctx context.Context, pullRequestId uuid.UUID,
+) (domain.PullRequestWithReviewers, error) {
+	return s.changeStatus(ctx, pullRequestId, transitionClose)
+}
+
+// ReopenPullRequest brings a CLOSED PR back to OPEN
+func (s *PullRequestService) ReopenPullRequest(
+	ctx context.Context, pullRequestId uuid.UUID,
+) (domain.PullRequestWithReviewers, error) {
+	return s.changeStatus(ctx, pullRequestId, transitionReopen)
+}
+
+// MarkReady turns a DRAFT into an OPEN PR and assigns its reviewers
+func (s *PullRequestService) MarkReady(
+	ctx context.Context, pullRequestId uuid.UUID,
+) (domain.PullRequestWithReviewers, error) {
+	return s.changeStatus(ctx, pullRequestId, transitionReady)
+}
+
+// getOpenPullRequest returns the PR if its reviewers may be changed
+func (s *PullRequestService) getOpenPullRequest(
+	ctx context.Context, pullRequestId uuid.UUID,
+) (domain.PullRequest, error) {
+	pr, err := s.pullRequestRepo.GetPullRequestById(ctx, pullRequestId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.PullRequest{}, ErrPullRequestNotFound
+		}
 		return domain.PullRequest{}, err
 	}
-	return pr, nil
+
+	switch pr.Status {
+	case domain.PullRequestStatusOPEN:
+		return pr, nil
+	case domain.PullRequestStatusMERGED:
+		return domain.PullRequest{}, ErrPullRequestMerged
+	default:
+		return domain.PullRequest{}, ErrPullRequestNotOpen
+	}
 }
 
-func (s *PullRequestService) Reassign(
+// checkReviewer applies the invariants of automatic selection to a
+// hand-picked reviewer
+func (s *PullRequestService) checkReviewer(
+	ctx context.Context,
+	author domain.User,
+	reviewerId uuid.UUID,
+) error {
+	if reviewerId == author.UserId {
+		return fmt.Errorf("%w: author cannot review own pull request", ErrInvalidReviewer)
+	}
+	if author.TeamId == uuid.Nil {
+		return fmt.Errorf("%w: author is not a member of any team", ErrInvalidReviewer)
+	}
+
+	reviewer, err := s.userRepo.GetUserById(ctx, reviewerId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return ErrUserNotFound
+		}
+		return err
+	}
+	if !reviewer.IsActive {
+		return fmt.Errorf("%w: reviewer is not active", ErrInvalidReviewer)
+	}
+
+	// candidates come from the author's team and its fallback teams
+	if reviewer.TeamId == author.TeamId {
+		return nil
+	}
+	fallbacks, err := s.teamRepo.ListFallbackTeams(ctx, author.TeamId)
+	if err != nil {
+		return err
+	}
+	for _, t := range fallbacks {
+		if t.TeamId == reviewer.TeamId {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: reviewer is not in the author's team or its fallback teams", ErrInvalidReviewer)
+}
+
+// AddReviewer assigns a hand-picked reviewer within the team's reviewers_required
+func (s *PullRequestService) AddReviewer(
 	ctx context.Context,
 	pullRequestId uuid.UUID,
-	oldUserId uuid.UUID,
+	reviewerId uuid.UUID,
 ) (domain.PullRequestWithReviewers, error) {
 	var result domain.PullRequestWithReviewers
 
 	err := s.trManager.Do(ctx, func(ctx context.Context) error {
-		// 1) получить PR
-		pr, err := s.pullRequestRepo.GetPullRequestById(ctx, pullRequestId)
+		// 1) получить открытый PR
+		pr, err := s.getOpenPullRequest(ctx, pullRequestId)
 		if err != nil {
-			if errors.Is(err, repoerrors.ErrNotFound) {
-				return ErrPullRequestNotFound
+			return err
+		}
+
+		// 2) проверить ревьювера
+		author, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
+		if err != nil {
+			return err
+		}
+		if err := s.checkReviewer(ctx, author, reviewerId); err != nil {
+			return err
+		}
+
+		// 3) проверить текущие назначения и лимит команды
+		assigned, err := s.reviewerRepo.ListReviewers(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(assigned, reviewerId) {
+			return ErrAlreadyAssigned
+		}
+		team, err := s.teamRepo.GetTeamById(ctx, author.TeamId)
+		if err != nil {
+			return err
+		}
+		if len(assigned) >= team.ReviewersRequired {
+			return fmt.Errorf("%w: %d", ErrTooManyReviewers, team.ReviewersRequired)
+		}
+
+		// 4) назначить
+		if err := s.reviewerRepo.AssignOne(ctx, pullRequestId, reviewerId); err != nil {
+			if errors.Is(err, repoerrors.ErrMerged) {
+				return ErrPullRequestMerged
 			}
 			return err
 		}
 
-		// 2) проверить статус PR (MERGED нельзя менять)
-		if pr.Status == domain.PullRequestStatusMERGED {
-			return ErrPullRequestMerged
+		events := assignedEvents(pullRequestId, []uuid.UUID{reviewerId}, "added manually")
+		if err := recordReviewerEvents(ctx, s.eventRepo, s.outbox, events...); err != nil {
+			return err
 		}
 
-		// 3) проверить, что oldUserId действительно назначен
-		assignedReviewers, err := s.reviewerRepo.ListReviewers(ctx, pullRequestId)
+		result, err = s.withReviews(ctx, pr)
+		return err
+	})
+
+	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+
+	return result, nil
+}
+
+// RemoveReviewer unassigns a reviewer without picking a replacement
+func (s *PullRequestService) RemoveReviewer(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	reviewerId uuid.UUID,
+) (domain.PullRequestWithReviewers, error) {
+	var result domain.PullRequestWithReviewers
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		pr, err := s.getOpenPullRequest(ctx, pullRequestId)
 		if err != nil {
 			return err
 		}
-		found := false
-		for _, uid := range assignedReviewers {
-			if uid == oldUserId {
-				found = true
-				break
+
+		if err := s.reviewerRepo.RemoveOne(ctx, pullRequestId, reviewerId); err != nil {
+			switch {
+			case errors.Is(err, repoerrors.ErrNotFound):
+				return ErrNotAssigned
+			case errors.Is(err, repoerrors.ErrMerged):
+				return ErrPullRequestMerged
+			default:
+				return err
 			}
 		}
-		if !found {
-			return ErrUserNotFound
+
+		err = recordReviewerEvents(ctx, s.eventRepo, s.outbox, domain.ReviewerEvent{
+			PullRequestId: pullRequestId,
+			Type:          domain.ReviewerEventUnassigned,
+			UserId:        &reviewerId,
+			Reason:        "removed manually",
+		})
+		if err != nil {
+			return err
+		}
+
+		result, err = s.withReviews(ctx, pr)
+		return err
+	})
+
+	if err != nil {
+		return domain.PullRequestWithReviewers{}, err
+	}
+
+	return result, nil
+}
+
+// Reassign replaces oldUserId with newUserId or, when it is nil, with a
+// reviewer picked by the team's selection strategy
+func (s *PullRequestService) Reassign(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	oldUserId uuid.UUID,
+	newUserId *uuid.UUID,
+) (domain.PullRequestReassignment, error) {
+	reason := "reassigned by selection strategy"
+	if newUserId != nil {
+		reason = "reassigned to chosen reviewer"
+	}
+	return s.reassign(ctx, pullRequestId, oldUserId, newUserId, domain.ReviewerEventReassigned, reason)
+}
+
+// ReassignForDeactivation hands the review of a deactivated reviewer over
+// to one picked by the team's selection strategy; ErrNoCandidate when nobody
+// can take it
+func (s *PullRequestService) ReassignForDeactivation(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	userId uuid.UUID,
+	reason string,
+) (domain.PullRequestReassignment, error) {
+	return s.reassign(ctx, pullRequestId, userId, nil, domain.ReviewerEventDeactivated, reason)
+}
+
+// reassign does the work of Reassign recording the change as eventType
+func (s *PullRequestService) reassign(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	oldUserId uuid.UUID,
+	newUserId *uuid.UUID,
+	eventType domain.ReviewerEventType,
+	reason string,
+) (domain.PullRequestReassignment, error) {
+	var result domain.PullRequestReassignment
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		// 1-2) получить PR и проверить статус (MERGED нельзя менять, DRAFT и CLOSED не на ревью)
+		pr, err := s.getOpenPullRequest(ctx, pullRequestId)
+		if err != nil {
+			return err
 		}
 
-		oldUser, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
+		// 3) проверить, что oldUserId действительно назначен
+		assignedReviewers, err := s.reviewerRepo.ListReviewers(ctx, pullRequestId)
 		if err != nil {
 			return err
 		}
+		if !slices.Contains(assignedReviewers, oldUserId) {
+			return ErrNotAssigned
+		}
 
-		// 4) выбрать кандидата на замену из команды автора
-		replacement, err := s.selectReplacement(ctx, oldUser.TeamId, pr.AuthorId, assignedReviewers, oldUserId)
+		author, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
 		if err != nil {
 			return err
 		}
 
+		// 4) взять указанного пользователя или выбрать кандидата на замену
+		// из команды автора (или резервных команд)
+		var replacement uuid.UUID
+		if newUserId != nil {
+			if err := s.checkReviewer(ctx, author, *newUserId); err != nil {
+				return err
+			}
+			if slices.Contains(assignedReviewers, *newUserId) {
+				return ErrAlreadyAssigned
+			}
+			replacement = *newUserId
+		} else {
+			replacement, err = s.selectReplacement(
+				ctx, pullRequestId, author.TeamId, pr.AuthorId, assignedReviewers, oldUserId,
+			)
+			if err != nil {
+				return err
+			}
+		}
+
 		// 5) снять oldUserId
 		if err := s.reviewerRepo.RemoveOne(ctx, pullRequestId, oldUserId); err != nil {
+			if errors.Is(err, repoerrors.ErrMerged) {
+				return ErrPullRequestMerged
+			}
 			return err
 		}
 
 		// 6) назначить нового ревьювера
 		if err := s.reviewerRepo.AssignOne(ctx, pullRequestId, replacement); err != nil {
+			if errors.Is(err, repoerrors.ErrMerged) {
+				return ErrPullRequestMerged
+			}
+			return err
+		}
+
+		// 7) записать в историю
+		err = recordReviewerEvents(ctx, s.eventRepo, s.outbox, domain.ReviewerEvent{
+			PullRequestId: pullRequestId,
+			Type:          eventType,
+			UserId:        &oldUserId,
+			ReplacedBy:    &replacement,
+			Reason:        reason,
+		})
+		if err != nil {
 			return err
 		}
 
-		// 7) собрать результат
+		// 8) собрать результат
 		updatedReviewers, err := s.reviewerRepo.ListReviewers(ctx, pullRequestId)
 		if err != nil {
 			return err
 		}
+		// переназначение сохраняет число ревьюверов PR, даже если
+		// reviewers_required команды с тех пор изменился
+		if len(updatedReviewers) != len(assignedReviewers) {
+			return ErrReviewerCountChanged
+		}
+
+		fallback, err := s.fallbackReviewers(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		reviews, err := s.reviewerRepo.ListReviews(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
 
 		result.PullRequest = pr
 		result.Reviewers = updatedReviewers
+		result.FallbackReviewers = fallback
+		result.Reviews = reviews
+		result.ReplacedBy = replacement
 		return nil
 	})
 
+	if err != nil {
+		return domain.PullRequestReassignment{}, err
+	}
+
+	return result, nil
+}
+
+// SubmitReview records the reviewer's verdict; it can be changed until merge
+func (s *PullRequestService) SubmitReview(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	reviewerId uuid.UUID,
+	state domain.ReviewState,
+) (domain.PullRequestWithReviewers, error) {
+	if state != domain.ReviewStateApproved && state != domain.ReviewStateChangesRequested {
+		return domain.PullRequestWithReviewers{}, ErrInvalidReviewState
+	}
+
+	var result domain.PullRequestWithReviewers
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		pr, err := s.getOpenPullRequest(ctx, pullRequestId)
+		if err != nil {
+			return err
+		}
+
+		_, err = s.reviewerRepo.SetReviewState(ctx, pullRequestId, reviewerId, state)
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			// a lead whose approval is required reviews without being picked
+			var assigned bool
+			assigned, err = s.assignLeadReviewer(ctx, pr, reviewerId)
+			if err == nil {
+				err = repoerrors.ErrNotFound
+				if assigned {
+					_, err = s.reviewerRepo.SetReviewState(ctx, pullRequestId, reviewerId, state)
+				}
+			}
+		}
+		if err != nil {
+			switch {
+			case errors.Is(err, repoerrors.ErrNotFound):
+				return ErrNotAssigned
+			case errors.Is(err, repoerrors.ErrMerged):
+				return ErrPullRequestMerged
+			default:
+				return err
+			}
+		}
+		if err := s.outbox.enqueue(ctx, reviewSubmittedEvent(pullRequestId, reviewerId, state)); err != nil {
+			return err
+		}
+
+		result, err = s.withReviews(ctx, pr)
+		return err
+	})
+
 	if err != nil {
 		return domain.PullRequestWithReviewers{}, err
 	}
@@ -305,19 +871,79 @@ func (s *PullRequestService) Reassign(
 	return result, nil
 }
 
+// assignLeadReviewer assigns the lead of the author's team when the team's
+// merge policy requires their approval; false for anyone else
+func (s *PullRequestService) assignLeadReviewer(
+	ctx context.Context, pr domain.PullRequest, userId uuid.UUID,
+) (bool, error) {
+	if userId == pr.AuthorId {
+		return false, nil
+	}
+
+	author, err := s.userRepo.GetUserById(ctx, pr.AuthorId)
+	if err != nil {
+		return false, err
+	}
+	if author.TeamId == uuid.Nil {
+		return false, nil
+	}
+
+	policy, err := s.mergePolicyRepo.GetByTeamId(ctx, author.TeamId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !policy.RequireLeadApproval || policy.LeadUserId == nil || *policy.LeadUserId != userId {
+		return false, nil
+	}
+
+	if err := s.reviewerRepo.AssignOne(ctx, pr.PullRequestId, userId); err != nil {
+		return false, err
+	}
+	events := assignedEvents(pr.PullRequestId, []uuid.UUID{userId}, "team lead review")
+	if err := recordReviewerEvents(ctx, s.eventRepo, s.outbox, events...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetHistory returns the PR's assignment history, oldest first
+func (s *PullRequestService) GetHistory(
+	ctx context.Context, pullRequestId uuid.UUID,
+) ([]domain.ReviewerEvent, error) {
+	if _, err := s.pullRequestRepo.GetPullRequestById(ctx, pullRequestId); err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, ErrPullRequestNotFound
+		}
+		return nil, err
+	}
+
+	return s.eventRepo.ListByPullRequestId(ctx, pullRequestId)
+}
+
 func (s *PullRequestService) GetAssignedReviewsByUserId(
 	ctx context.Context,
 	userId uuid.UUID,
+	state *domain.ReviewState,
 ) ([]domain.PullRequestShort, error) {
-	// 1) получить список PR, где user назначен
-	prIDs, err := s.reviewerRepo.ListByUserId(ctx, userId)
+	// 1) получить ревью пользователя (с фильтром по состоянию)
+	reviews, err := s.reviewerRepo.ListReviewsByUserId(ctx, userId, state)
 	if err != nil {
 		return nil, err
 	}
-	if len(prIDs) == 0 {
+	if len(reviews) == 0 {
 		return []domain.PullRequestShort{}, nil
 	}
 
+	prIDs := make([]uuid.UUID, len(reviews))
+	states := make(map[uuid.UUID]domain.ReviewState, len(reviews))
+	for i, r := range reviews {
+		prIDs[i] = r.PullRequestId
+		states[r.PullRequestId] = r.State
+	}
+
 	// 2) получить краткую информацию о PR
 	prs, err := s.pullRequestRepo.GetPullRequestsByIds(ctx, prIDs)
 	if err != nil {
@@ -327,11 +953,13 @@ func (s *PullRequestService) GetAssignedReviewsByUserId(
 	// 3) собрать результат
 	result := make([]domain.PullRequestShort, len(prs))
 	for i, pr := range prs {
+		reviewState := states[pr.PullRequestId]
 		result[i] = domain.PullRequestShort{
 			PullRequestId:   pr.PullRequestId,
 			PullRequestName: pr.PullRequestName,
 			AuthorId:        pr.AuthorId,
 			Status:          pr.Status,
+			ReviewState:     &reviewState,
 		}
 	}
 
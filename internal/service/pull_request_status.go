@@ -0,0 +1,40 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"fmt"
+	"slices"
+)
+
+// statusTransition is one edge group of the PR state machine: a PR may move
+// to To only from one of From. MERGED is terminal
+type statusTransition struct {
+	From []domain.PullRequestStatus
+	To   domain.PullRequestStatus
+}
+
+var (
+	transitionReady = statusTransition{
+		From: []domain.PullRequestStatus{domain.PullRequestStatusDRAFT},
+		To:   domain.PullRequestStatusOPEN,
+	}
+	transitionClose = statusTransition{
+		From: []domain.PullRequestStatus{domain.PullRequestStatusDRAFT, domain.PullRequestStatusOPEN},
+		To:   domain.PullRequestStatusCLOSED,
+	}
+	transitionReopen = statusTransition{
+		From: []domain.PullRequestStatus{domain.PullRequestStatusCLOSED},
+		To:   domain.PullRequestStatusOPEN,
+	}
+	transitionMerge = statusTransition{
+		From: []domain.PullRequestStatus{domain.PullRequestStatusOPEN},
+		To:   domain.PullRequestStatusMERGED,
+	}
+)
+
+func (t statusTransition) check(current domain.PullRequestStatus) error {
+	if slices.Contains(t.From, current) {
+		return nil
+	}
+	return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, current, t.To)
+}
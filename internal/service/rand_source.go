@@ -0,0 +1,42 @@
+package service
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RandSource provides the random generator used for one reviewer selection
+type RandSource interface {
+	ForPullRequest(pullRequestId uuid.UUID) *rand.Rand
+}
+
+// TimeRandSource seeds every generator with the current time, so selections
+// are not reproducible
+type TimeRandSource struct{}
+
+func NewTimeRandSource() *TimeRandSource {
+	return &TimeRandSource{}
+}
+
+func (s *TimeRandSource) ForPullRequest(_ uuid.UUID) *rand.Rand {
+	return rand.New(rand.NewSource(time.Now().UnixNano()))
+}
+
+// PullRequestRandSource derives the seed from the PR id mixed with a base
+// seed: the same PR always gets the same sequence
+type PullRequestRandSource struct {
+	seed int64
+}
+
+func NewPullRequestRandSource(seed int64) *PullRequestRandSource {
+	return &PullRequestRandSource{seed: seed}
+}
+
+func (s *PullRequestRandSource) ForPullRequest(pullRequestId uuid.UUID) *rand.Rand {
+	hi := int64(binary.BigEndian.Uint64(pullRequestId[:8]))
+	lo := int64(binary.BigEndian.Uint64(pullRequestId[8:]))
+	return rand.New(rand.NewSource(s.seed ^ hi ^ lo))
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// recordReviewerEvents appends history entries on behalf of the request's
+// actor and records the events derived from them; it must run in
+// the transaction that makes the change
+func recordReviewerEvents(
+	ctx context.Context,
+	eventRepo repo.ReviewerEvent,
+	outbox eventOutbox,
+	events ...domain.ReviewerEvent,
+) error {
+	actor := domain.ActorFromContext(ctx)
+	for i := range events {
+		events[i].ActorId = actor
+	}
+	if err := eventRepo.Append(ctx, events...); err != nil {
+		return err
+	}
+	return outbox.enqueue(ctx, fromReviewerEvents(events)...)
+}
+
+// assignedEvents one ASSIGNED event per reviewer
+func assignedEvents(
+	pullRequestId uuid.UUID,
+	reviewers []uuid.UUID,
+	reason string,
+) []domain.ReviewerEvent {
+	events := make([]domain.ReviewerEvent, len(reviewers))
+	for i := range reviewers {
+		events[i] = domain.ReviewerEvent{
+			PullRequestId: pullRequestId,
+			Type:          domain.ReviewerEventAssigned,
+			UserId:        &reviewers[i],
+			Reason:        reason,
+		}
+	}
+	return events
+}
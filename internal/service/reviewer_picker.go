@@ -0,0 +1,277 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// reviewerPicker selects reviewers by the team's strategy from the home team
+// and, once it is exhausted, from its fallback teams. PR and team services
+// share it, so assignments and handovers follow the same rules
+type reviewerPicker struct {
+	userRepo         repo.User
+	teamRepo         repo.Team
+	teamSettingsRepo repo.TeamSettings
+	selectors        ReviewerSelectors
+	defaultStrategy  domain.SelectionStrategy
+	randSource       RandSource
+}
+
+func newReviewerPicker(
+	repos *repo.Repositories,
+	selectors ReviewerSelectors,
+	defaultStrategy domain.SelectionStrategy,
+	randSource RandSource,
+) reviewerPicker {
+	return reviewerPicker{
+		userRepo:         repos.User,
+		teamRepo:         repos.Team,
+		teamSettingsRepo: repos.TeamSettings,
+		selectors:        selectors,
+		defaultStrategy:  defaultStrategy,
+		randSource:       randSource,
+	}
+}
+
+// candidatePool everything a selection for one home team needs
+type candidatePool struct {
+	selector ReviewerSelector
+	// teamIds home team first, then fallback teams in priority order
+	teamIds []uuid.UUID
+	members map[uuid.UUID][]uuid.UUID
+}
+
+// selectorForTeam resolves the team's configured strategy, falling back to the default one
+func (p reviewerPicker) selectorForTeam(
+	ctx context.Context,
+	teamId uuid.UUID,
+) (ReviewerSelector, error) {
+	strategy := p.defaultStrategy
+
+	settings, err := p.teamSettingsRepo.GetByTeamId(ctx, teamId)
+	if err != nil && !errors.Is(err, repoerrors.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil {
+		strategy = settings.SelectionStrategy
+	}
+
+	selector, ok := p.selectors[strategy]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownSelectionStrategy, strategy)
+	}
+	return selector, nil
+}
+
+func (p reviewerPicker) loadPool(
+	ctx context.Context,
+	teamId uuid.UUID,
+) (candidatePool, error) {
+	// the home team's strategy is used for fallback teams as well
+	selector, err := p.selectorForTeam(ctx, teamId)
+	if err != nil {
+		return candidatePool{}, err
+	}
+
+	fallbacks, err := p.teamRepo.ListFallbackTeams(ctx, teamId)
+	if err != nil {
+		return candidatePool{}, err
+	}
+	teamIds := make([]uuid.UUID, 0, len(fallbacks)+1)
+	teamIds = append(teamIds, teamId)
+	for _, t := range fallbacks {
+		teamIds = append(teamIds, t.TeamId)
+	}
+
+	// active members of the home and fallback teams in one query
+	users, err := p.userRepo.GetActiveUsersByTeamIds(ctx, teamIds)
+	if err != nil {
+		return candidatePool{}, err
+	}
+	members := make(map[uuid.UUID][]uuid.UUID, len(teamIds))
+	for _, u := range users {
+		members[u.TeamId] = append(members[u.TeamId], u.UserId)
+	}
+
+	return candidatePool{selector: selector, teamIds: teamIds, members: members}, nil
+}
+
+// pick selects up to n reviewers team by team; picked ones are added to excluded
+func (p reviewerPicker) pick(
+	ctx context.Context,
+	pool candidatePool,
+	pullRequestId uuid.UUID,
+	excluded map[uuid.UUID]struct{},
+	n int,
+) ([]uuid.UUID, error) {
+	r := p.randSource.ForPullRequest(pullRequestId)
+	selected := make([]uuid.UUID, 0, n)
+	for _, tid := range pool.teamIds {
+		if len(selected) >= n {
+			break
+		}
+
+		candidates := make([]uuid.UUID, 0, len(pool.members[tid]))
+		for _, id := range pool.members[tid] {
+			if _, skip := excluded[id]; !skip {
+				candidates = append(candidates, id)
+			}
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+
+		sortCandidates(candidates)
+		picked, err := pool.selector.Select(ctx, r, candidates, n-len(selected))
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range picked {
+			excluded[id] = struct{}{}
+		}
+		selected = append(selected, picked...)
+	}
+
+	return selected, nil
+}
+
+// selectReviewers picks up to n reviewers for a PR of the given team
+func (p reviewerPicker) selectReviewers(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	teamId uuid.UUID,
+	excluded map[uuid.UUID]struct{},
+	n int,
+) ([]uuid.UUID, error) {
+	pool, err := p.loadPool(ctx, teamId)
+	if err != nil {
+		return nil, err
+	}
+	return p.pick(ctx, pool, pullRequestId, excluded, n)
+}
+
+// reviewerBatch picks reviewers for many PRs at once: pools are loaded once
+// per team and the candidates' load once for all of them, then kept up to
+// date in memory as reviewers are picked
+type reviewerBatch struct {
+	picker reviewerPicker
+	load   *loadSnapshot
+	pools  map[uuid.UUID]candidatePool
+}
+
+// newBatch prepares pools for the given home teams; the built-in selectors
+// are bound to the batch's load snapshot
+func (p reviewerPicker) newBatch(
+	ctx context.Context,
+	reviewerLoad ReviewerLoad,
+	teamIds []uuid.UUID,
+) (*reviewerBatch, error) {
+	load := &loadSnapshot{}
+	picker := p
+	picker.selectors = newReviewerSelectors(load)
+
+	pools := make(map[uuid.UUID]candidatePool, len(teamIds))
+	userIds := make([]uuid.UUID, 0)
+	seen := make(map[uuid.UUID]struct{})
+	for _, tid := range teamIds {
+		if _, ok := pools[tid]; ok {
+			continue
+		}
+		pool, err := picker.loadPool(ctx, tid)
+		if err != nil {
+			return nil, err
+		}
+		pools[tid] = pool
+
+		// fallback teams may be shared by several pools
+		for _, ids := range pool.members {
+			for _, id := range ids {
+				if _, ok := seen[id]; !ok {
+					seen[id] = struct{}{}
+					userIds = append(userIds, id)
+				}
+			}
+		}
+	}
+
+	var err error
+	load.open, err = reviewerLoad.CountOpenByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+	load.lastAssigned, err = reviewerLoad.LastAssignedAtByUserIds(ctx, userIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &reviewerBatch{picker: picker, load: load, pools: pools}, nil
+}
+
+// selectReplacement picks one reviewer for a PR of the given team and counts
+// the assignment in the load; false when nobody can take it
+func (b *reviewerBatch) selectReplacement(
+	ctx context.Context,
+	pullRequestId uuid.UUID,
+	teamId uuid.UUID,
+	excluded map[uuid.UUID]struct{},
+) (uuid.UUID, bool, error) {
+	pool, ok := b.pools[teamId]
+	if !ok {
+		return uuid.Nil, false, nil
+	}
+
+	picked, err := b.picker.pick(ctx, pool, pullRequestId, excluded, 1)
+	if err != nil {
+		return uuid.Nil, false, err
+	}
+	if len(picked) == 0 {
+		return uuid.Nil, false, nil
+	}
+
+	b.load.assign(picked[0])
+	return picked[0], true, nil
+}
+
+// loadSnapshot ReviewerLoad held in memory for the duration of a batch
+type loadSnapshot struct {
+	open         map[uuid.UUID]int
+	lastAssigned map[uuid.UUID]time.Time
+}
+
+func (l *loadSnapshot) CountOpenByUserIds(
+	_ context.Context,
+	userIds []uuid.UUID,
+) (map[uuid.UUID]int, error) {
+	out := make(map[uuid.UUID]int, len(userIds))
+	for _, id := range userIds {
+		if n, ok := l.open[id]; ok {
+			out[id] = n
+		}
+	}
+	return out, nil
+}
+
+func (l *loadSnapshot) LastAssignedAtByUserIds(
+	_ context.Context,
+	userIds []uuid.UUID,
+) (map[uuid.UUID]time.Time, error) {
+	out := make(map[uuid.UUID]time.Time, len(userIds))
+	for _, id := range userIds {
+		if t, ok := l.lastAssigned[id]; ok {
+			out[id] = t
+		}
+	}
+	return out, nil
+}
+
+func (l *loadSnapshot) assign(userId uuid.UUID) {
+	l.open[userId]++
+	l.lastAssigned[userId] = time.Now()
+}
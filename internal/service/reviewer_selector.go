@@ -0,0 +1,230 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"context"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func ParseSelectionStrategy(s string) (domain.SelectionStrategy, error) {
+	switch strategy := domain.SelectionStrategy(s); strategy {
+	case domain.SelectionStrategyRandom,
+		domain.SelectionStrategyLeastLoaded,
+		domain.SelectionStrategyRoundRobin,
+		domain.SelectionStrategyWeighted:
+		return strategy, nil
+	default:
+		return "", ErrUnknownSelectionStrategy
+	}
+}
+
+// ReviewerSelector picks up to n reviewers out of already filtered candidates
+type ReviewerSelector interface {
+	Select(
+		ctx context.Context,
+		r *rand.Rand,
+		candidates []uuid.UUID,
+		n int,
+	) ([]uuid.UUID, error)
+}
+
+// ReviewerLoad is what load-aware selectors read about candidates
+type ReviewerLoad interface {
+	CountOpenByUserIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) (map[uuid.UUID]int, error)
+	LastAssignedAtByUserIds(
+		ctx context.Context,
+		userIds []uuid.UUID,
+	) (map[uuid.UUID]time.Time, error)
+}
+
+// ReviewerSelectors maps every known strategy to its implementation
+type ReviewerSelectors map[domain.SelectionStrategy]ReviewerSelector
+
+func NewReviewerSelectors(repos *repo.Repositories) ReviewerSelectors {
+	return newReviewerSelectors(repos.Reviewer)
+}
+
+func newReviewerSelectors(load ReviewerLoad) ReviewerSelectors {
+	return ReviewerSelectors{
+		domain.SelectionStrategyRandom:      NewRandomSelector(),
+		domain.SelectionStrategyLeastLoaded: NewLeastLoadedSelector(load),
+		domain.SelectionStrategyRoundRobin:  NewRoundRobinSelector(load),
+		domain.SelectionStrategyWeighted:    NewWeightedSelector(load),
+	}
+}
+
+type RandomSelector struct{}
+
+func NewRandomSelector() *RandomSelector {
+	return &RandomSelector{}
+}
+
+func (s *RandomSelector) Select(
+	_ context.Context,
+	r *rand.Rand,
+	candidates []uuid.UUID,
+	n int,
+) ([]uuid.UUID, error) {
+	shuffled := shuffleCandidates(r, candidates)
+	return takeFirst(shuffled, n), nil
+}
+
+// LeastLoadedSelector prefers candidates with the fewest OPEN review
+// assignments, ties are broken randomly
+type LeastLoadedSelector struct {
+	load ReviewerLoad
+}
+
+func NewLeastLoadedSelector(load ReviewerLoad) *LeastLoadedSelector {
+	return &LeastLoadedSelector{load: load}
+}
+
+func (s *LeastLoadedSelector) Select(
+	ctx context.Context,
+	r *rand.Rand,
+	candidates []uuid.UUID,
+	n int,
+) ([]uuid.UUID, error) {
+	if len(candidates) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	load, err := s.load.CountOpenByUserIds(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	// shuffle first so that stable sort keeps random order among equal loads
+	ranked := shuffleCandidates(r, candidates)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return load[ranked[i]] < load[ranked[j]]
+	})
+
+	return takeFirst(ranked, n), nil
+}
+
+// RoundRobinSelector rotates through the team: whoever was assigned
+// least recently (or never) goes first
+type RoundRobinSelector struct {
+	load ReviewerLoad
+}
+
+func NewRoundRobinSelector(load ReviewerLoad) *RoundRobinSelector {
+	return &RoundRobinSelector{load: load}
+}
+
+func (s *RoundRobinSelector) Select(
+	ctx context.Context,
+	_ *rand.Rand,
+	candidates []uuid.UUID,
+	n int,
+) ([]uuid.UUID, error) {
+	if len(candidates) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	lastAssigned, err := s.load.LastAssignedAtByUserIds(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := make([]uuid.UUID, len(candidates))
+	copy(ranked, candidates)
+	sort.Slice(ranked, func(i, j int) bool {
+		ti, tj := lastAssigned[ranked[i]], lastAssigned[ranked[j]]
+		if !ti.Equal(tj) {
+			return ti.Before(tj)
+		}
+		return ranked[i].String() < ranked[j].String()
+	})
+
+	return takeFirst(ranked, n), nil
+}
+
+// WeightedSelector draws candidates randomly with probability
+// proportional to 1 / (1 + open assignments)
+type WeightedSelector struct {
+	load ReviewerLoad
+}
+
+func NewWeightedSelector(load ReviewerLoad) *WeightedSelector {
+	return &WeightedSelector{load: load}
+}
+
+func (s *WeightedSelector) Select(
+	ctx context.Context,
+	r *rand.Rand,
+	candidates []uuid.UUID,
+	n int,
+) ([]uuid.UUID, error) {
+	if len(candidates) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	load, err := s.load.CountOpenByUserIds(ctx, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := make([]uuid.UUID, len(candidates))
+	copy(pool, candidates)
+	weights := make([]float64, len(pool))
+	total := 0.0
+	for i, id := range pool {
+		weights[i] = 1 / float64(1+load[id])
+		total += weights[i]
+	}
+
+	selected := make([]uuid.UUID, 0, n)
+	for len(selected) < n && len(pool) > 0 {
+		// draw one and remove it from the pool
+		point := r.Float64() * total
+		idx := len(pool) - 1
+		for i, w := range weights {
+			if point < w {
+				idx = i
+				break
+			}
+			point -= w
+		}
+
+		selected = append(selected, pool[idx])
+		total -= weights[idx]
+		pool = append(pool[:idx], pool[idx+1:]...)
+		weights = append(weights[:idx], weights[idx+1:]...)
+	}
+
+	return selected, nil
+}
+
+func shuffleCandidates(r *rand.Rand, candidates []uuid.UUID) []uuid.UUID {
+	shuffled := make([]uuid.UUID, len(candidates))
+	copy(shuffled, candidates)
+
+	r.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
+
+// sortCandidates gives candidates a stable order so that seeded selections are reproducible
+func sortCandidates(candidates []uuid.UUID) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].String() < candidates[j].String()
+	})
+}
+
+func takeFirst(candidates []uuid.UUID, n int) []uuid.UUID {
+	if len(candidates) > n {
+		return candidates[:n]
+	}
+	return candidates
+}
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"time"
 
 	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/repo"
@@ -14,12 +15,68 @@ type Team interface {
 	CreateTeamWithUsers(
 		ctx context.Context,
 		teamName string,
+		reviewersRequired int,
 		members []domain.UserInput,
-	) (domain.TeamWithUsers, error)
+		moveExisting bool,
+	) (domain.TeamCreation, error)
 	GetTeamByName(
 		ctx context.Context,
 		teamName string,
 	) (domain.TeamWithUsers, error)
+	GetTeamSettings(
+		ctx context.Context,
+		teamName string,
+	) (domain.TeamSettings, error)
+	UpdateTeamSettings(
+		ctx context.Context,
+		teamName string,
+		strategy domain.SelectionStrategy,
+		reviewersRequired *int,
+	) (domain.TeamSettings, error)
+	GetFallbackTeams(
+		ctx context.Context,
+		teamName string,
+	) (domain.TeamFallbacks, error)
+	SetFallbackTeams(
+		ctx context.Context,
+		teamName string,
+		fallbackTeamNames []string,
+	) (domain.TeamFallbacks, error)
+	GetMergePolicy(
+		ctx context.Context,
+		teamName string,
+	) (domain.MergePolicy, error)
+	UpdateMergePolicy(
+		ctx context.Context,
+		teamName string,
+		policy domain.MergePolicy,
+	) (domain.MergePolicy, error)
+	DeactivateUsers(
+		ctx context.Context,
+		teamName string,
+		userIds []uuid.UUID,
+		all bool,
+	) (domain.TeamDeactivation, error)
+	AddMembers(
+		ctx context.Context,
+		teamName string,
+		members []domain.UserInput,
+	) (domain.TeamWithUsers, error)
+	RemoveMember(
+		ctx context.Context,
+		teamName string,
+		userId uuid.UUID,
+	) (domain.MembershipChange, error)
+	MoveUser(
+		ctx context.Context,
+		userId uuid.UUID,
+		teamName string,
+	) (domain.MembershipChange, error)
+	RenameTeam(
+		ctx context.Context,
+		teamName string,
+		newTeamName string,
+	) (domain.TeamWithUsers, error)
 }
 
 type User interface {
@@ -31,7 +88,11 @@ type User interface {
 		ctx context.Context,
 		userId uuid.UUID,
 		isActive bool,
-	) (domain.UserWithTeamName, error)
+	) (domain.UserActivityChange, error)
+	GetTeamHistory(
+		ctx context.Context,
+		userId uuid.UUID,
+	) ([]domain.MembershipEvent, error)
 }
 
 type PullRequest interface {
@@ -40,37 +101,203 @@ type PullRequest interface {
 		pullRequestId uuid.UUID,
 		pullRequestName string,
 		authorId uuid.UUID,
+		draft bool,
+	) (domain.PullRequestWithReviewers, error)
+	GetPullRequestById(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
 	) (domain.PullRequestWithReviewers, error)
 	SetMerged(
 		ctx context.Context,
 		pullRequestId uuid.UUID,
-	) (domain.PullRequest, error)
+		force bool,
+	) (domain.PullRequestWithReviewers, error)
+	ClosePullRequest(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) (domain.PullRequestWithReviewers, error)
+	ReopenPullRequest(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) (domain.PullRequestWithReviewers, error)
+	MarkReady(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) (domain.PullRequestWithReviewers, error)
+	AddReviewer(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		reviewerId uuid.UUID,
+	) (domain.PullRequestWithReviewers, error)
+	RemoveReviewer(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		reviewerId uuid.UUID,
+	) (domain.PullRequestWithReviewers, error)
 	Reassign(
 		ctx context.Context,
 		pullRequestId uuid.UUID,
 		oldUserId uuid.UUID,
+		newUserId *uuid.UUID,
+	) (domain.PullRequestReassignment, error)
+	ReassignForDeactivation(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		userId uuid.UUID,
+		reason string,
+	) (domain.PullRequestReassignment, error)
+	SubmitReview(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+		reviewerId uuid.UUID,
+		state domain.ReviewState,
 	) (domain.PullRequestWithReviewers, error)
+	GetHistory(
+		ctx context.Context,
+		pullRequestId uuid.UUID,
+	) ([]domain.ReviewerEvent, error)
 	GetAssignedReviewsByUserId(
 		ctx context.Context,
 		userId uuid.UUID,
+		state *domain.ReviewState,
 	) ([]domain.PullRequestShort, error)
+	ListPullRequests(
+		ctx context.Context,
+		teamName *string,
+		filter domain.PullRequestFilter,
+	) (domain.PullRequestPage, error)
+}
+
+type Stats interface {
+	GetStats(ctx context.Context) (domain.Stats, error)
+	GetTeamStats(
+		ctx context.Context,
+		teamName string,
+	) (domain.Stats, error)
+}
+
+type Auth interface {
+	Authenticate(
+		ctx context.Context,
+		token string,
+	) (domain.Principal, error)
+	IssueToken(
+		ctx context.Context,
+		role domain.Role,
+		userId *uuid.UUID,
+	) (string, domain.APIToken, error)
+	RevokeToken(
+		ctx context.Context,
+		tokenId uuid.UUID,
+	) (domain.APIToken, error)
+	EnsureAdminToken(
+		ctx context.Context,
+		token string,
+	) error
+}
+
+type Idempotency interface {
+	Begin(
+		ctx context.Context,
+		scope string,
+		key string,
+		requestHash []byte,
+	) (*domain.IdempotencyRecord, error)
+	Complete(
+		ctx context.Context,
+		scope string,
+		key string,
+		statusCode int,
+		body []byte,
+	) error
+	Release(
+		ctx context.Context,
+		scope string,
+		key string,
+	) error
+	DeleteExpired(
+		ctx context.Context,
+	) (int64, error)
+}
+
+type Webhook interface {
+	CreateWebhook(
+		ctx context.Context,
+		url string,
+		eventTypes []domain.EventType,
+	) (string, domain.Webhook, error)
+	ListWebhooks(
+		ctx context.Context,
+	) ([]domain.Webhook, error)
+	UpdateWebhook(
+		ctx context.Context,
+		webhookId uuid.UUID,
+		update domain.WebhookUpdate,
+	) (domain.Webhook, error)
+	DeleteWebhook(
+		ctx context.Context,
+		webhookId uuid.UUID,
+	) (domain.Webhook, error)
+}
+
+type Event interface {
+	ListEvents(
+		ctx context.Context,
+		after *domain.EventCursor,
+		limit int,
+	) (domain.EventPage, error)
+	LastCursor(
+		ctx context.Context,
+	) (*domain.EventCursor, error)
 }
 
 type Services struct {
 	Team        Team
 	User        User
 	PullRequest PullRequest
+	Stats       Stats
+	Auth        Auth
+	Idempotency Idempotency
+	Webhook     Webhook
+	Event       Event
 }
 
 type ServicesDependencies struct {
-	Repos     *repo.Repositories
-	TrManager *postgres.TransactionManager
+	Repos             *repo.Repositories
+	TrManager         *postgres.TransactionManager
+	SelectionStrategy domain.SelectionStrategy
+	// RandSource drives random reviewer selection, time-seeded when nil
+	RandSource RandSource
+	// JWTVerifier enables JWT bearer tokens, only API tokens when nil
+	JWTVerifier JWTVerifier
+	// IdempotencyTTL how long responses are kept for Idempotency-Key replays,
+	// DefaultIdempotencyTTL when zero
+	IdempotencyTTL time.Duration
+	// IdempotencyLease how long a request in progress holds its key,
+	// DefaultIdempotencyLease when zero
+	IdempotencyLease time.Duration
 }
 
 func NewServices(deps ServicesDependencies) *Services {
+	selectors := NewReviewerSelectors(deps.Repos)
+
+	randSource := deps.RandSource
+	if randSource == nil {
+		randSource = NewTimeRandSource()
+	}
+
+	pullRequestService := NewPullRequestService(
+		deps.Repos, deps.TrManager, selectors, deps.SelectionStrategy, randSource,
+	)
+
 	return &Services{
-		Team:        NewTeamService(deps.Repos, deps.TrManager),
-		User:        NewUserService(deps.Repos, deps.TrManager),
-		PullRequest: NewPullRequestService(deps.Repos, deps.TrManager),
+		Team:        NewTeamService(deps.Repos, deps.TrManager, selectors, deps.SelectionStrategy, randSource),
+		User:        NewUserService(deps.Repos, deps.TrManager, pullRequestService),
+		PullRequest: pullRequestService,
+		Stats:       NewStatsService(deps.Repos),
+		Auth:        NewAuthService(deps.Repos, deps.JWTVerifier),
+		Idempotency: NewIdempotencyService(deps.Repos, deps.IdempotencyTTL, deps.IdempotencyLease),
+		Webhook:     NewWebhookService(deps.Repos, deps.TrManager),
+		Event:       NewEventService(deps.Repos, deps.TrManager),
 	}
 }
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"context"
+	"errors"
+)
+
+type StatsService struct {
+	statsRepo repo.Stats
+	teamRepo  repo.Team
+}
+
+func NewStatsService(repos *repo.Repositories) *StatsService {
+	return &StatsService{
+		statsRepo: repos.Stats,
+		teamRepo:  repos.Team,
+	}
+}
+
+func (s *StatsService) GetStats(ctx context.Context) (domain.Stats, error) {
+	return s.statsRepo.GetStats(ctx, nil)
+}
+
+func (s *StatsService) GetTeamStats(
+	ctx context.Context, teamName string,
+) (domain.Stats, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.Stats{}, ErrNotFound
+		}
+		return domain.Stats{}, err
+	}
+
+	return s.statsRepo.GetStats(ctx, &team.TeamId)
+}
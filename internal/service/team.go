@@ -8,28 +8,64 @@ import (
 	"avito-test-applicant/pkg/postgres"
 	"context"
 	"errors"
+
+	"github.com/google/uuid"
 )
 
 type TeamService struct {
-	teamRepo  repo.Team
-	userRepo  repo.User
-	trManager postgres.TransactionManager
+	teamRepo         repo.Team
+	userRepo         repo.User
+	pullRequestRepo  repo.PullRequest
+	reviewerRepo     repo.Reviewer
+	teamSettingsRepo repo.TeamSettings
+	mergePolicyRepo  repo.TeamMergePolicy
+	eventRepo        repo.ReviewerEvent
+	outbox           eventOutbox
+	membershipRepo   repo.MembershipEvent
+	trManager        postgres.TransactionManager
+	picker           reviewerPicker
+	defaultStrategy  domain.SelectionStrategy
 }
 
-func NewTeamService(repos *repo.Repositories, trManager *postgres.TransactionManager) *TeamService {
+func NewTeamService(
+	repos *repo.Repositories,
+	trManager *postgres.TransactionManager,
+	selectors ReviewerSelectors,
+	defaultStrategy domain.SelectionStrategy,
+	randSource RandSource,
+) *TeamService {
 	return &TeamService{
-		teamRepo:  repos.Team,
-		userRepo:  repos.User,
-		trManager: *trManager,
+		teamRepo:         repos.Team,
+		userRepo:         repos.User,
+		pullRequestRepo:  repos.PullRequest,
+		reviewerRepo:     repos.Reviewer,
+		teamSettingsRepo: repos.TeamSettings,
+		mergePolicyRepo:  repos.TeamMergePolicy,
+		eventRepo:        repos.ReviewerEvent,
+		outbox:           newEventOutbox(repos),
+		membershipRepo:   repos.MembershipEvent,
+		trManager:        *trManager,
+		picker:           newReviewerPicker(repos, selectors, defaultStrategy, randSource),
+		defaultStrategy:  defaultStrategy,
 	}
 }
 
+// CreateTeamWithUsers creates the team and its members. Existing users are
+// never taken from their teams silently: without moveExisting the call fails
+// with UsersInOtherTeamError, with it they are moved and their open reviews
+// are handed over within the old teams
 func (s *TeamService) CreateTeamWithUsers(
 	ctx context.Context,
 	teamName string,
+	reviewersRequired int,
 	members []domain.UserInput,
-) (domain.TeamWithUsers, error) {
-	var result domain.TeamWithUsers
+	moveExisting bool,
+) (domain.TeamCreation, error) {
+	if reviewersRequired < 1 {
+		return domain.TeamCreation{}, ErrInvalidReviewersRequired
+	}
+
+	var result domain.TeamCreation
 
 	err := s.trManager.Do(ctx, func(ctx context.Context) error {
 		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
@@ -41,44 +77,103 @@ func (s *TeamService) CreateTeamWithUsers(
 			return ErrTeamAlreadyExists
 		}
 
+		// 1) найти уже существующих пользователей
+		existing := make(map[uuid.UUID]domain.User, len(members))
+		var conflicts []domain.UserWithTeamName
+		for _, m := range members {
+			user, err := s.userRepo.GetUserById(ctx, m.UserId)
+			if err != nil {
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					continue
+				}
+				return err
+			}
+			existing[user.UserId] = user
+			// a user removed from their team is free to join without a move
+			if user.TeamId == uuid.Nil {
+				continue
+			}
+			current, err := s.teamNameById(ctx, user.TeamId)
+			if err != nil {
+				return err
+			}
+			conflicts = append(conflicts, domain.UserWithTeamName{
+				UserId:   user.UserId,
+				Username: user.Username,
+				IsActive: user.IsActive,
+				TeamName: current,
+			})
+		}
+		if len(conflicts) > 0 && !moveExisting {
+			return &UsersInOtherTeamError{Users: conflicts}
+		}
+
+		// 2) создать команду
 		teamId := id.NewUUID()
-		team, err = s.teamRepo.CreateTeam(ctx, teamId, teamName)
+		team, err = s.teamRepo.CreateTeam(ctx, teamId, teamName, reviewersRequired)
 		if err != nil {
 			if errors.Is(err, repoerrors.ErrAlreadyExists) {
 				return ErrTeamAlreadyExists
 			}
 			return err
 		}
-		var createdOrUpdatedUsers []domain.User
 
-		for _, userInput := range members {
-			user, err := s.userRepo.CreateUser(ctx, userInput.UserId, userInput.Username, userInput.IsActive, team.TeamId)
+		// 3) создать новых и перевести существующих участников
+		users := make([]domain.User, 0, len(members))
+		for _, m := range members {
+			var user domain.User
+			if _, ok := existing[m.UserId]; ok {
+				user, err = s.userRepo.UpdateUser(ctx, domain.User{
+					UserId:   m.UserId,
+					TeamId:   team.TeamId,
+					IsActive: m.IsActive,
+					Username: m.Username,
+				})
+			} else {
+				user, err = s.userRepo.CreateUser(ctx, m.UserId, m.Username, m.IsActive, team.TeamId)
+			}
 			if err != nil {
-				if errors.Is(err, repoerrors.ErrAlreadyExists) {
-					user = domain.User{
-						UserId:   userInput.UserId,
-						TeamId:   team.TeamId,
-						IsActive: userInput.IsActive,
-						Username: userInput.Username,
-					}
-					user, err = s.userRepo.UpdateUser(ctx, user)
-					if err != nil {
-						return err
-					}
-				} else {
-					return err
+				if errors.Is(err, repoerrors.ErrUsernameTakenInTeam) {
+					return ErrUsernameTaken
 				}
+				return err
+			}
+			users = append(users, user)
+		}
+
+		// 4) передать ревью переведённых, когда все они уже покинули старые команды
+		reason := "reviewer moved to team " + team.TeamName
+		moved := make([]domain.MembershipChange, 0, len(conflicts))
+		for _, c := range conflicts {
+			oldTeamId := existing[c.UserId].TeamId
+			reviews, err := s.handOverReviews(ctx, c.UserId, oldTeamId, reason)
+			if err != nil {
+				return err
+			}
+			err = recordMembershipEvent(ctx, s.membershipRepo, c.UserId, oldTeamId, team.TeamId, "moved on team creation")
+			if err != nil {
+				return err
+			}
+			moved = append(moved, domain.MembershipChange{User: c, Reviews: reviews})
+		}
+		for _, m := range members {
+			if user, ok := existing[m.UserId]; !ok || user.TeamId != uuid.Nil {
+				continue
+			}
+			err = recordMembershipEvent(ctx, s.membershipRepo, m.UserId, uuid.Nil, team.TeamId, "added on team creation")
+			if err != nil {
+				return err
 			}
-			createdOrUpdatedUsers = append(createdOrUpdatedUsers, user)
 		}
 
 		result.Team = team
-		result.Users = createdOrUpdatedUsers
+		result.Users = users
+		result.Moved = moved
 		return nil
 	})
 
 	if err != nil {
-		return domain.TeamWithUsers{}, err
+		return domain.TeamCreation{}, err
 	}
 
 	return result, nil
@@ -105,3 +200,691 @@ func (s *TeamService) GetTeamByName(
 		Users: users,
 	}, nil
 }
+
+func (s *TeamService) GetTeamSettings(
+	ctx context.Context, teamName string,
+) (domain.TeamSettings, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.TeamSettings{}, ErrNotFound
+		}
+		return domain.TeamSettings{}, err
+	}
+
+	settings, err := s.teamSettingsRepo.GetByTeamId(ctx, team.TeamId)
+	if err != nil {
+		// team without explicit settings uses configured defaults
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.TeamSettings{
+				TeamId:            team.TeamId,
+				SelectionStrategy: s.defaultStrategy,
+				ReviewersRequired: team.ReviewersRequired,
+			}, nil
+		}
+		return domain.TeamSettings{}, err
+	}
+	settings.ReviewersRequired = team.ReviewersRequired
+
+	return settings, nil
+}
+
+// UpdateTeamSettings sets the selection strategy and, when given, the number
+// of reviewers for new PRs; open PRs keep the reviewers they have
+func (s *TeamService) UpdateTeamSettings(
+	ctx context.Context, teamName string, strategy domain.SelectionStrategy, reviewersRequired *int,
+) (domain.TeamSettings, error) {
+	if _, err := ParseSelectionStrategy(string(strategy)); err != nil {
+		return domain.TeamSettings{}, err
+	}
+	if reviewersRequired != nil && *reviewersRequired < 1 {
+		return domain.TeamSettings{}, ErrInvalidReviewersRequired
+	}
+
+	var result domain.TeamSettings
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if reviewersRequired != nil {
+			team, err = s.teamRepo.SetReviewersRequired(ctx, team.TeamId, *reviewersRequired)
+			if err != nil {
+				return err
+			}
+		}
+
+		result, err = s.teamSettingsRepo.Upsert(ctx, domain.TeamSettings{
+			TeamId:            team.TeamId,
+			SelectionStrategy: strategy,
+		})
+		result.ReviewersRequired = team.ReviewersRequired
+		return err
+	})
+
+	if err != nil {
+		return domain.TeamSettings{}, err
+	}
+
+	return result, nil
+}
+
+func (s *TeamService) GetMergePolicy(
+	ctx context.Context, teamName string,
+) (domain.MergePolicy, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.MergePolicy{}, ErrNotFound
+		}
+		return domain.MergePolicy{}, err
+	}
+
+	policy, err := s.mergePolicyRepo.GetByTeamId(ctx, team.TeamId)
+	if err != nil {
+		// no policy - merge is not gated
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.MergePolicy{TeamId: team.TeamId}, nil
+		}
+		return domain.MergePolicy{}, err
+	}
+
+	return policy, nil
+}
+
+func (s *TeamService) UpdateMergePolicy(
+	ctx context.Context, teamName string, policy domain.MergePolicy,
+) (domain.MergePolicy, error) {
+	if policy.RequiredApprovals < 0 {
+		return domain.MergePolicy{}, ErrInvalidMergePolicy
+	}
+	if policy.RequireLeadApproval && policy.LeadUserId == nil {
+		return domain.MergePolicy{}, ErrInvalidMergePolicy
+	}
+
+	var result domain.MergePolicy
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		// lead must be a member of the team
+		if policy.LeadUserId != nil {
+			lead, err := s.userRepo.GetUserById(ctx, *policy.LeadUserId)
+			if err != nil {
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					return ErrUserNotInTeam
+				}
+				return err
+			}
+			if lead.TeamId != team.TeamId {
+				return ErrUserNotInTeam
+			}
+		}
+
+		policy.TeamId = team.TeamId
+		result, err = s.mergePolicyRepo.Upsert(ctx, policy)
+		return err
+	})
+
+	if err != nil {
+		return domain.MergePolicy{}, err
+	}
+
+	return result, nil
+}
+
+func (s *TeamService) GetFallbackTeams(
+	ctx context.Context, teamName string,
+) (domain.TeamFallbacks, error) {
+	team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.TeamFallbacks{}, ErrNotFound
+		}
+		return domain.TeamFallbacks{}, err
+	}
+
+	fallbacks, err := s.teamRepo.ListFallbackTeams(ctx, team.TeamId)
+	if err != nil {
+		return domain.TeamFallbacks{}, err
+	}
+
+	return domain.TeamFallbacks{
+		Team:          team,
+		FallbackTeams: fallbacks,
+	}, nil
+}
+
+func (s *TeamService) SetFallbackTeams(
+	ctx context.Context, teamName string, fallbackTeamNames []string,
+) (domain.TeamFallbacks, error) {
+	var result domain.TeamFallbacks
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		seen := make(map[string]struct{}, len(fallbackTeamNames))
+		fallbacks := make([]domain.Team, 0, len(fallbackTeamNames))
+		fallbackIds := make([]uuid.UUID, 0, len(fallbackTeamNames))
+		for _, name := range fallbackTeamNames {
+			if _, dup := seen[name]; dup || name == teamName {
+				return ErrInvalidFallbackTeam
+			}
+			seen[name] = struct{}{}
+
+			fallback, err := s.teamRepo.GetTeamByName(ctx, name)
+			if err != nil {
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					return ErrNotFound
+				}
+				return err
+			}
+			fallbacks = append(fallbacks, fallback)
+			fallbackIds = append(fallbackIds, fallback.TeamId)
+		}
+
+		if err := s.teamRepo.ReplaceFallbackTeams(ctx, team.TeamId, fallbackIds); err != nil {
+			return err
+		}
+
+		result.Team = team
+		result.FallbackTeams = fallbacks
+		return nil
+	})
+
+	if err != nil {
+		return domain.TeamFallbacks{}, err
+	}
+
+	return result, nil
+}
+
+// AddMembers creates new users directly in an existing team. Users that
+// already exist are rejected: moving between teams goes through MoveUser
+func (s *TeamService) AddMembers(
+	ctx context.Context, teamName string, members []domain.UserInput,
+) (domain.TeamWithUsers, error) {
+	var result domain.TeamWithUsers
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		for _, m := range members {
+			_, err := s.userRepo.GetUserById(ctx, m.UserId)
+			if err == nil {
+				return ErrUserAlreadyExists
+			}
+			if !errors.Is(err, repoerrors.ErrNotFound) {
+				return err
+			}
+
+			_, err = s.userRepo.CreateUser(ctx, m.UserId, m.Username, m.IsActive, team.TeamId)
+			if err != nil {
+				switch {
+				case errors.Is(err, repoerrors.ErrUsernameTakenInTeam):
+					return ErrUsernameTaken
+				case errors.Is(err, repoerrors.ErrAlreadyExists):
+					return ErrUserAlreadyExists
+				}
+				return err
+			}
+		}
+
+		users, err := s.userRepo.GetUsersByTeam(ctx, team.TeamId)
+		if err != nil {
+			return err
+		}
+
+		result.Team = team
+		result.Users = users
+		return nil
+	})
+
+	if err != nil {
+		return domain.TeamWithUsers{}, err
+	}
+
+	return result, nil
+}
+
+// RemoveMember detaches the user from the team and deactivates them. Their
+// open reviews are handed over like a reassignment of each PR; reviews
+// nobody can take are unassigned. PRs they authored are left as is
+func (s *TeamService) RemoveMember(
+	ctx context.Context, teamName string, userId uuid.UUID,
+) (domain.MembershipChange, error) {
+	var result domain.MembershipChange
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		user, err := s.userRepo.GetUserById(ctx, userId)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+		if user.TeamId != team.TeamId {
+			return ErrUserNotInTeam
+		}
+
+		user, err = s.userRepo.RemoveFromTeam(ctx, userId)
+		if err != nil {
+			return err
+		}
+
+		reviews, err := s.redistributeOpenReviews(
+			ctx, []uuid.UUID{userId},
+			domain.ReviewerEventReassigned, "reviewer removed from team",
+		)
+		if err != nil {
+			return err
+		}
+		err = recordMembershipEvent(ctx, s.membershipRepo, userId, team.TeamId, uuid.Nil, "removed from team")
+		if err != nil {
+			return err
+		}
+
+		result.User = domain.UserWithTeamName{
+			UserId:   user.UserId,
+			Username: user.Username,
+			IsActive: user.IsActive,
+		}
+		result.Reviews = reviews
+		return nil
+	})
+
+	if err != nil {
+		return domain.MembershipChange{}, err
+	}
+
+	return result, nil
+}
+
+// MoveUser moves the user to another team. Their open reviews are handed
+// over like a reassignment of each PR; reviews nobody can take are
+// unassigned. PRs they authored keep their reviewers, new ones use the new team
+func (s *TeamService) MoveUser(
+	ctx context.Context, userId uuid.UUID, teamName string,
+) (domain.MembershipChange, error) {
+	var result domain.MembershipChange
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		target, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		user, err := s.userRepo.GetUserById(ctx, userId)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		result.User = domain.UserWithTeamName{
+			UserId:   user.UserId,
+			Username: user.Username,
+			IsActive: user.IsActive,
+			TeamName: target.TeamName,
+		}
+		result.Reviews = []domain.ReviewHandover{}
+
+		if user.TeamId == target.TeamId {
+			return nil
+		}
+		oldTeamId := user.TeamId
+
+		user, err = s.userRepo.MoveToTeam(ctx, userId, target.TeamId)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrUsernameTakenInTeam) {
+				return ErrUsernameTaken
+			}
+			return err
+		}
+
+		result.Reviews, err = s.handOverReviews(ctx, userId, oldTeamId, "reviewer moved to team "+target.TeamName)
+		if err != nil {
+			return err
+		}
+
+		return recordMembershipEvent(ctx, s.membershipRepo, userId, oldTeamId, target.TeamId, "moved")
+	})
+
+	if err != nil {
+		return domain.MembershipChange{}, err
+	}
+
+	return result, nil
+}
+
+func (s *TeamService) RenameTeam(
+	ctx context.Context, teamName string, newTeamName string,
+) (domain.TeamWithUsers, error) {
+	var result domain.TeamWithUsers
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		team, err = s.teamRepo.RenameTeam(ctx, team.TeamId, newTeamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrAlreadyExists) {
+				return ErrTeamAlreadyExists
+			}
+			return err
+		}
+
+		users, err := s.userRepo.GetUsersByTeam(ctx, team.TeamId)
+		if err != nil {
+			return err
+		}
+
+		result.Team = team
+		result.Users = users
+		return nil
+	})
+
+	if err != nil {
+		return domain.TeamWithUsers{}, err
+	}
+
+	return result, nil
+}
+
+// handOverReviews hands over open reviews of a user who left oldTeamId;
+// reviews nobody can take are unassigned
+func (s *TeamService) handOverReviews(
+	ctx context.Context, userId uuid.UUID, oldTeamId uuid.UUID, reason string,
+) ([]domain.ReviewHandover, error) {
+	// a user without a team has nothing to hand over
+	if oldTeamId == uuid.Nil {
+		return []domain.ReviewHandover{}, nil
+	}
+
+	return s.redistributeOpenReviews(
+		ctx, []uuid.UUID{userId}, domain.ReviewerEventReassigned, reason,
+	)
+}
+
+// unassignLeftover removes the reviews nobody could take over: a user who
+// left the team or was deactivated must not keep reviewing its PRs
+func (s *TeamService) unassignLeftover(
+	ctx context.Context, reviews []domain.ReviewHandover, reason string,
+) error {
+	var dropped []domain.ReviewAssignment
+	var events []domain.ReviewerEvent
+	for _, r := range reviews {
+		if r.ReplacedBy != nil {
+			continue
+		}
+		dropped = append(dropped, domain.ReviewAssignment{
+			PullRequestId: r.PullRequestId,
+			UserId:        r.UserId,
+		})
+		events = append(events, domain.ReviewerEvent{
+			PullRequestId: r.PullRequestId,
+			Type:          domain.ReviewerEventUnassigned,
+			UserId:        &r.UserId,
+			Reason:        reason,
+		})
+	}
+	if err := s.reviewerRepo.RemoveMany(ctx, dropped); err != nil {
+		return err
+	}
+	return recordReviewerEvents(ctx, s.eventRepo, s.outbox, events...)
+}
+
+// teamNameById returns "" for users removed from their team
+func (s *TeamService) teamNameById(ctx context.Context, teamId uuid.UUID) (string, error) {
+	if teamId == uuid.Nil {
+		return "", nil
+	}
+	team, err := s.teamRepo.GetTeamById(ctx, teamId)
+	if err != nil {
+		return "", err
+	}
+	return team.TeamName, nil
+}
+
+// DeactivateUsers deactivates the given members (or the whole team when all is
+// set) and hands their open reviews over like a reassignment of each PR;
+// reviews nobody can take are unassigned, as when a member leaves the team.
+// Everything is loaded and written in bulk, so the cost does not grow with
+// the number of round trips per PR
+func (s *TeamService) DeactivateUsers(
+	ctx context.Context, teamName string, userIds []uuid.UUID, all bool,
+) (domain.TeamDeactivation, error) {
+	var result domain.TeamDeactivation
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		team, err := s.teamRepo.GetTeamByName(ctx, teamName)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		members, err := s.userRepo.GetUsersByTeam(ctx, team.TeamId)
+		if err != nil {
+			return err
+		}
+
+		// 1) определить, кого деактивируем
+		targets := make(map[uuid.UUID]struct{}, len(members))
+		if all {
+			for _, m := range members {
+				targets[m.UserId] = struct{}{}
+			}
+		} else {
+			isMember := make(map[uuid.UUID]struct{}, len(members))
+			for _, m := range members {
+				isMember[m.UserId] = struct{}{}
+			}
+			for _, uid := range userIds {
+				if _, ok := isMember[uid]; !ok {
+					return ErrUserNotInTeam
+				}
+				targets[uid] = struct{}{}
+			}
+		}
+
+		targetIds := make([]uuid.UUID, 0, len(targets))
+		for uid := range targets {
+			targetIds = append(targetIds, uid)
+		}
+		sortCandidates(targetIds)
+
+		// 2) деактивировать одним запросом
+		if _, err := s.userRepo.SetIsActiveByIds(ctx, targetIds, false); err != nil {
+			return err
+		}
+		deactivated := make([]domain.Event, 0, len(targetIds))
+		for _, m := range members {
+			if _, ok := targets[m.UserId]; ok && m.IsActive {
+				deactivated = append(deactivated, userActivityEvent(m.UserId, false))
+			}
+		}
+		if err := s.outbox.enqueue(ctx, deactivated...); err != nil {
+			return err
+		}
+
+		// 3) перераспределить открытые ревью
+		reviews, err := s.redistributeOpenReviews(
+			ctx, targetIds,
+			domain.ReviewerEventDeactivated, "team members deactivated",
+		)
+		if err != nil {
+			return err
+		}
+
+		result.TeamName = team.TeamName
+		result.Deactivated = targetIds
+		result.Reviews = reviews
+		return nil
+	})
+
+	if err != nil {
+		return domain.TeamDeactivation{}, err
+	}
+
+	return result, nil
+}
+
+// redistributeOpenReviews moves open reviews of leaving users to reviewers
+// picked the same way as by Reassign: the strategy, members and fallback
+// teams of the author's team. Leaving users must already be inactive or out
+// of those teams. Reviews nobody can take are unassigned
+func (s *TeamService) redistributeOpenReviews(
+	ctx context.Context,
+	leaving []uuid.UUID,
+	eventType domain.ReviewerEventType,
+	reason string,
+) ([]domain.ReviewHandover, error) {
+	open, err := s.reviewerRepo.ListOpenByUserIds(ctx, leaving)
+	if err != nil {
+		return nil, err
+	}
+	if len(open) == 0 {
+		return []domain.ReviewHandover{}, nil
+	}
+
+	prIds := make([]uuid.UUID, 0, len(open))
+	seen := make(map[uuid.UUID]struct{}, len(open))
+	for _, a := range open {
+		if _, ok := seen[a.PullRequestId]; !ok {
+			seen[a.PullRequestId] = struct{}{}
+			prIds = append(prIds, a.PullRequestId)
+		}
+	}
+
+	prs, err := s.pullRequestRepo.GetPullRequestsByIds(ctx, prIds)
+	if err != nil {
+		return nil, err
+	}
+	authors := make(map[uuid.UUID]uuid.UUID, len(prs))
+	authorIds := make([]uuid.UUID, 0, len(prs))
+	for _, pr := range prs {
+		authors[pr.PullRequestId] = pr.AuthorId
+		authorIds = append(authorIds, pr.AuthorId)
+	}
+
+	authorUsers, err := s.userRepo.GetUsersByIds(ctx, authorIds)
+	if err != nil {
+		return nil, err
+	}
+	teamOf := make(map[uuid.UUID]uuid.UUID, len(authorUsers))
+	teamIds := make([]uuid.UUID, 0, len(authorUsers))
+	for _, u := range authorUsers {
+		teamOf[u.UserId] = u.TeamId
+		// authors removed from their team have nobody to pick from
+		if u.TeamId != uuid.Nil {
+			teamIds = append(teamIds, u.TeamId)
+		}
+	}
+
+	reviewers, err := s.reviewerRepo.ListReviewersByPullRequestIds(ctx, prIds)
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.picker.newBatch(ctx, s.reviewerRepo, teamIds)
+	if err != nil {
+		return nil, err
+	}
+
+	handovers := make([]domain.ReviewHandover, 0, len(open))
+	removed := make([]domain.ReviewAssignment, 0, len(open))
+	assigned := make([]domain.ReviewAssignment, 0, len(open))
+	events := make([]domain.ReviewerEvent, 0, len(open))
+	for _, a := range open {
+		handover := domain.ReviewHandover{
+			PullRequestId: a.PullRequestId,
+			UserId:        a.UserId,
+		}
+
+		authorId := authors[a.PullRequestId]
+		excluded := map[uuid.UUID]struct{}{authorId: {}}
+		for _, uid := range reviewers[a.PullRequestId] {
+			excluded[uid] = struct{}{}
+		}
+
+		replacement, ok, err := batch.selectReplacement(ctx, a.PullRequestId, teamOf[authorId], excluded)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			reviewers[a.PullRequestId] = append(reviewers[a.PullRequestId], replacement)
+			removed = append(removed, a)
+			assigned = append(assigned, domain.ReviewAssignment{
+				PullRequestId: a.PullRequestId,
+				UserId:        replacement,
+			})
+			handover.ReplacedBy = &replacement
+			events = append(events, domain.ReviewerEvent{
+				PullRequestId: a.PullRequestId,
+				Type:          eventType,
+				UserId:        &handover.UserId,
+				ReplacedBy:    &replacement,
+				Reason:        reason,
+			})
+		}
+
+		handovers = append(handovers, handover)
+	}
+
+	if err := s.reviewerRepo.RemoveMany(ctx, removed); err != nil {
+		return nil, err
+	}
+	if err := s.reviewerRepo.AssignMany(ctx, assigned); err != nil {
+		return nil, err
+	}
+	if err := recordReviewerEvents(ctx, s.eventRepo, s.outbox, events...); err != nil {
+		return nil, err
+	}
+	if err := s.unassignLeftover(ctx, handovers, reason); err != nil {
+		return nil, err
+	}
+
+	return handovers, nil
+}
@@ -12,19 +12,28 @@ import (
 )
 
 type UserService struct {
-	userRepo  repo.User
-	teamRepo  repo.Team
-	trManager postgres.TransactionManager
+	userRepo     repo.User
+	teamRepo     repo.Team
+	reviewerRepo repo.Reviewer
+	eventRepo    repo.MembershipEvent
+	pullRequest  PullRequest
+	outbox       eventOutbox
+	trManager    postgres.TransactionManager
 }
 
 func NewUserService(
 	repos *repo.Repositories,
 	trManager *postgres.TransactionManager,
+	pullRequest PullRequest,
 ) *UserService {
 	return &UserService{
-		userRepo:  repos.User,
-		teamRepo:  repos.Team,
-		trManager: *trManager,
+		userRepo:     repos.User,
+		teamRepo:     repos.Team,
+		reviewerRepo: repos.Reviewer,
+		eventRepo:    repos.MembershipEvent,
+		pullRequest:  pullRequest,
+		outbox:       newEventOutbox(repos),
+		trManager:    *trManager,
 	}
 }
 
@@ -41,28 +50,110 @@ func (s *UserService) GetUserById(
 	return user, nil
 }
 
+// GetTeamHistory returns the user's team changes, oldest first
+func (s *UserService) GetTeamHistory(
+	ctx context.Context, userId uuid.UUID,
+) ([]domain.MembershipEvent, error) {
+	if _, err := s.userRepo.GetUserById(ctx, userId); err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+
+	return s.eventRepo.ListByUserId(ctx, userId)
+}
+
 func (s *UserService) SetIsActive(
 	ctx context.Context, userId uuid.UUID, isActive bool,
-) (domain.UserWithTeamName, error) {
-	user, err := s.userRepo.SetIsActive(ctx, userId, isActive)
-	if err != nil {
-		if errors.Is(err, repoerrors.ErrNotFound) {
-			return domain.UserWithTeamName{}, ErrNotFound
+) (domain.UserActivityChange, error) {
+	var result domain.UserActivityChange
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		previous, err := s.userRepo.GetUserById(ctx, userId)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		user, err := s.userRepo.SetIsActive(ctx, userId, isActive)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+		// setting the current value again is not a change
+		if previous.IsActive != user.IsActive {
+			if err := s.outbox.enqueue(ctx, userActivityEvent(userId, user.IsActive)); err != nil {
+				return err
+			}
+		}
+		// a user removed from their team has no team name
+		var teamName string
+		if user.TeamId != uuid.Nil {
+			team, err := s.teamRepo.GetTeamById(ctx, user.TeamId)
+			if err != nil {
+				if errors.Is(err, repoerrors.ErrNotFound) {
+					return ErrNotFound
+				}
+				return err
+			}
+			teamName = team.TeamName
 		}
-		return domain.UserWithTeamName{}, err
+
+		result.User = domain.UserWithTeamName{
+			IsActive: user.IsActive,
+			TeamName: teamName,
+			UserId:   user.UserId,
+			Username: user.Username,
+		}
+		result.Reassigned = []domain.ReviewReassignment{}
+		result.NotReassigned = []uuid.UUID{}
+
+		if isActive {
+			return nil
+		}
+
+		// deactivated reviewer hands over every open review in the same transaction
+		return s.reassignOpenReviews(ctx, userId, &result)
+	})
+
+	if err != nil {
+		return domain.UserActivityChange{}, err
 	}
-	team, err := s.teamRepo.GetTeamById(ctx, user.TeamId)
+
+	return result, nil
+}
+
+func (s *UserService) reassignOpenReviews(
+	ctx context.Context,
+	userId uuid.UUID,
+	result *domain.UserActivityChange,
+) error {
+	prIDs, err := s.reviewerRepo.ListOpenByUserId(ctx, userId)
 	if err != nil {
-		if errors.Is(err, repoerrors.ErrNotFound) {
-			return domain.UserWithTeamName{}, ErrNotFound
+		return err
+	}
+
+	for _, prID := range prIDs {
+		reassignment, err := s.pullRequest.ReassignForDeactivation(ctx, prID, userId, "reviewer deactivated")
+		if err != nil {
+			// nobody can take over: the reviewer stays assigned
+			if errors.Is(err, ErrNoCandidate) {
+				result.NotReassigned = append(result.NotReassigned, prID)
+				continue
+			}
+			return err
 		}
-		return domain.UserWithTeamName{}, err
+
+		result.Reassigned = append(result.Reassigned, domain.ReviewReassignment{
+			PullRequestId: prID,
+			ReplacedBy:    reassignment.ReplacedBy,
+		})
 	}
 
-	return domain.UserWithTeamName{
-		IsActive: user.IsActive,
-		TeamName: team.TeamName,
-		UserId:   user.UserId,
-		Username: user.Username,
-	}, nil
+	return nil
 }
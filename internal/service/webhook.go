@@ -0,0 +1,151 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/internal/repo/repoerrors"
+	"avito-test-applicant/internal/utils/id"
+	"avito-test-applicant/pkg/postgres"
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+type WebhookService struct {
+	webhookRepo repo.Webhook
+	trManager   postgres.TransactionManager
+}
+
+func NewWebhookService(
+	repos *repo.Repositories,
+	trManager *postgres.TransactionManager,
+) *WebhookService {
+	return &WebhookService{
+		webhookRepo: repos.Webhook,
+		trManager:   *trManager,
+	}
+}
+
+// CreateWebhook registers an active webhook; the signing secret is
+// returned only here
+func (s *WebhookService) CreateWebhook(
+	ctx context.Context, rawURL string, eventTypes []domain.EventType,
+) (string, domain.Webhook, error) {
+	eventTypes, err := validateWebhook(rawURL, eventTypes)
+	if err != nil {
+		return "", domain.Webhook{}, err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return "", domain.Webhook{}, fmt.Errorf("generate webhook secret: %w", err)
+	}
+	secret := base64.RawURLEncoding.EncodeToString(raw)
+
+	created, err := s.webhookRepo.CreateWebhook(ctx, domain.Webhook{
+		WebhookId:  id.NewUUID(),
+		URL:        rawURL,
+		EventTypes: eventTypes,
+		IsActive:   true,
+	}, secret)
+	if err != nil {
+		return "", domain.Webhook{}, err
+	}
+
+	return secret, created, nil
+}
+
+func (s *WebhookService) ListWebhooks(
+	ctx context.Context,
+) ([]domain.Webhook, error) {
+	return s.webhookRepo.ListWebhooks(ctx, false)
+}
+
+// UpdateWebhook changes the set fields; deliveries of a deactivated
+// webhook are kept and sent once it is active again
+func (s *WebhookService) UpdateWebhook(
+	ctx context.Context, webhookId uuid.UUID, update domain.WebhookUpdate,
+) (domain.Webhook, error) {
+	var result domain.Webhook
+
+	err := s.trManager.Do(ctx, func(ctx context.Context) error {
+		webhook, err := s.webhookRepo.GetWebhookById(ctx, webhookId)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+
+		if update.URL != nil {
+			webhook.URL = *update.URL
+		}
+		if update.EventTypes != nil {
+			webhook.EventTypes = *update.EventTypes
+		}
+		if update.IsActive != nil {
+			webhook.IsActive = *update.IsActive
+		}
+
+		webhook.EventTypes, err = validateWebhook(webhook.URL, webhook.EventTypes)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.webhookRepo.UpdateWebhook(ctx, webhook)
+		if err != nil {
+			if errors.Is(err, repoerrors.ErrNotFound) {
+				return ErrNotFound
+			}
+			return err
+		}
+		return nil
+	})
+
+	if err != nil {
+		return domain.Webhook{}, err
+	}
+	return result, nil
+}
+
+// DeleteWebhook removes the webhook, its undelivered events are dropped
+func (s *WebhookService) DeleteWebhook(
+	ctx context.Context, webhookId uuid.UUID,
+) (domain.Webhook, error) {
+	webhook, err := s.webhookRepo.DeleteWebhook(ctx, webhookId)
+	if err != nil {
+		if errors.Is(err, repoerrors.ErrNotFound) {
+			return domain.Webhook{}, ErrNotFound
+		}
+		return domain.Webhook{}, err
+	}
+	return webhook, nil
+}
+
+// validateWebhook checks the URL is absolute http(s) and event types are
+// known, returns the event types without duplicates
+func validateWebhook(
+	rawURL string, eventTypes []domain.EventType,
+) ([]domain.EventType, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: url must be an absolute http or https url", ErrInvalidWebhook)
+	}
+
+	unique := make([]domain.EventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		if !slices.Contains(domain.EventTypes, t) {
+			return nil, fmt.Errorf("%w: unknown event type %q", ErrInvalidWebhook, t)
+		}
+		if !slices.Contains(unique, t) {
+			unique = append(unique, t)
+		}
+	}
+	return unique, nil
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo"
+	"avito-test-applicant/pkg/postgres"
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderWebhookEvent     = "X-Webhook-Event"
+	HeaderWebhookEventId   = "X-Webhook-Event-Id"
+	HeaderWebhookTimestamp = "X-Webhook-Timestamp"
+	// HeaderWebhookSignature "sha256=" and hex HMAC-SHA256 of
+	// "<timestamp>.<body>" keyed with the webhook secret
+	HeaderWebhookSignature = "X-Webhook-Signature"
+)
+
+type WebhookDispatcherConfig struct {
+	// Timeout of one delivery attempt
+	Timeout time.Duration
+	// MaxAttempts after which a delivery is marked FAILED
+	MaxAttempts int
+	// BaseBackoff delay after the first failed attempt, doubled after
+	// every next one up to MaxBackoff
+	BaseBackoff time.Duration
+	MaxBackoff  time.Duration
+	// BatchSize deliveries claimed at once
+	BatchSize int
+}
+
+// DefaultWebhookDispatcherConfig used for zero fields of the config
+var DefaultWebhookDispatcherConfig = WebhookDispatcherConfig{
+	Timeout:     10 * time.Second,
+	MaxAttempts: 10,
+	BaseBackoff: 5 * time.Second,
+	MaxBackoff:  time.Hour,
+	BatchSize:   50,
+}
+
+// WebhookDispatcher sends deliveries queued by the outbox. A delivery is
+// claimed for the time it takes to send the batch, so if the process dies
+// mid-way it is sent again later: receivers get events at least once and
+// dedupe them by event id
+type WebhookDispatcher struct {
+	deliveryRepo repo.WebhookDelivery
+	trManager    postgres.TransactionManager
+	client       *http.Client
+	cfg          WebhookDispatcherConfig
+}
+
+func NewWebhookDispatcher(
+	repos *repo.Repositories,
+	trManager *postgres.TransactionManager,
+	cfg WebhookDispatcherConfig,
+) *WebhookDispatcher {
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultWebhookDispatcherConfig.Timeout
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultWebhookDispatcherConfig.MaxAttempts
+	}
+	if cfg.BaseBackoff <= 0 {
+		cfg.BaseBackoff = DefaultWebhookDispatcherConfig.BaseBackoff
+	}
+	if cfg.MaxBackoff < cfg.BaseBackoff {
+		cfg.MaxBackoff = max(DefaultWebhookDispatcherConfig.MaxBackoff, cfg.BaseBackoff)
+	}
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultWebhookDispatcherConfig.BatchSize
+	}
+
+	return &WebhookDispatcher{
+		deliveryRepo: repos.WebhookDelivery,
+		trManager:    *trManager,
+		client: &http.Client{
+			// a redirect is a failed attempt, the body is not resent elsewhere
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		cfg: cfg,
+	}
+}
+
+// DispatchPending sends one batch of due deliveries and returns its size;
+// a failed attempt is retried after an exponential backoff
+func (d *WebhookDispatcher) DispatchPending(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+	lease := d.cfg.Timeout * time.Duration(d.cfg.BatchSize+1)
+
+	var batch []domain.WebhookDispatch
+	err := d.trManager.Do(ctx, func(ctx context.Context) error {
+		var err error
+		batch, err = d.deliveryRepo.ClaimDue(ctx, now, now.Add(lease), d.cfg.BatchSize)
+		return err
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, dispatch := range batch {
+		delivery := dispatch.Delivery
+		attempts := delivery.Attempts + 1
+
+		sendErr := d.send(ctx, dispatch)
+		switch {
+		case sendErr == nil:
+			err = d.deliveryRepo.MarkDelivered(ctx, delivery.Id, attempts, time.Now().UTC())
+		case attempts >= d.cfg.MaxAttempts:
+			err = d.deliveryRepo.MarkFailed(ctx, delivery.Id, attempts, sendErr.Error())
+		default:
+			next := time.Now().UTC().Add(d.backoff(attempts))
+			err = d.deliveryRepo.MarkRetry(ctx, delivery.Id, attempts, sendErr.Error(), next)
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(batch), nil
+}
+
+// backoff delay before the attempt following the given number of attempts
+func (d *WebhookDispatcher) backoff(attempts int) time.Duration {
+	delay := d.cfg.BaseBackoff
+	for i := 1; i < attempts && delay < d.cfg.MaxBackoff; i++ {
+		delay *= 2
+	}
+	return min(delay, d.cfg.MaxBackoff)
+}
+
+// send posts the payload; any status but 2xx is a failure
+func (d *WebhookDispatcher) send(ctx context.Context, dispatch domain.WebhookDispatch) error {
+	ctx, cancel := context.WithTimeout(ctx, d.cfg.Timeout)
+	defer cancel()
+
+	delivery := dispatch.Delivery
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dispatch.URL, bytes.NewReader(delivery.Payload))
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderWebhookEvent, string(delivery.EventType))
+	req.Header.Set(HeaderWebhookEventId, delivery.EventId.String())
+	req.Header.Set(HeaderWebhookTimestamp, timestamp)
+	req.Header.Set(HeaderWebhookSignature, SignWebhookPayload(dispatch.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain a bit so the connection can be reused
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// SignWebhookPayload value of HeaderWebhookSignature; the timestamp is
+// signed too so a captured request cannot be replayed later
+func SignWebhookPayload(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
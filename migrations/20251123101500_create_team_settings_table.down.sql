@@ -0,0 +1 @@
+drop table team_settings;
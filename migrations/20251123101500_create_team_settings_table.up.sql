@@ -0,0 +1,6 @@
+create table team_settings (
+    team_id            uuid        not null primary key references teams (
+        id
+    ) on delete cascade,
+    selection_strategy varchar(32) not null
+);
@@ -0,0 +1,2 @@
+alter table pr_reviewers
+drop column assigned_at;
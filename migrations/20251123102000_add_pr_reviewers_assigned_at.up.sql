@@ -0,0 +1,2 @@
+alter table pr_reviewers
+add column assigned_at timestamptz not null default now();
@@ -0,0 +1,2 @@
+alter table teams
+drop column reviewers_required;
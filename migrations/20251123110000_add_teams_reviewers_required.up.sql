@@ -0,0 +1,3 @@
+alter table teams
+add column reviewers_required smallint not null default 2
+check (reviewers_required > 0);
@@ -0,0 +1,11 @@
+create table team_fallbacks (
+    team_id          uuid     not null references teams (
+        id
+    ) on delete cascade,
+    fallback_team_id uuid     not null references teams (
+        id
+    ) on delete cascade,
+    priority         smallint not null,
+    primary key (team_id, fallback_team_id),
+    constraint team_fallbacks_not_self check (team_id <> fallback_team_id)
+);
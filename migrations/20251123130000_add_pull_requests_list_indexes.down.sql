@@ -0,0 +1,3 @@
+drop index idx_pull_requests_status_created_at;
+drop index idx_pull_requests_author_id_created_at;
+drop index idx_pull_requests_created_at_id;
@@ -0,0 +1,7 @@
+create index idx_pull_requests_created_at_id on pull_requests (created_at, id);
+create index idx_pull_requests_author_id_created_at on pull_requests (
+    author_id, created_at, id
+);
+create index idx_pull_requests_status_created_at on pull_requests (
+    pr_status, created_at, id
+);
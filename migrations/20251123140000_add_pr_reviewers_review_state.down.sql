@@ -0,0 +1,3 @@
+alter table pr_reviewers
+drop column reviewed_at,
+drop column review_state;
@@ -0,0 +1,4 @@
+alter table pr_reviewers
+add column review_state varchar(32) not null default 'PENDING'
+check (review_state in ('PENDING', 'APPROVED', 'CHANGES_REQUESTED')),
+add column reviewed_at timestamptz;
@@ -0,0 +1 @@
+drop table team_merge_policies;
@@ -0,0 +1,9 @@
+create table team_merge_policies (
+    team_id               uuid     not null primary key references teams (
+        id
+    ) on delete cascade,
+    required_approvals    smallint not null default 0
+    check (required_approvals >= 0),
+    require_lead_approval boolean  not null default false,
+    lead_user_id          uuid references users (id) on delete set null
+);
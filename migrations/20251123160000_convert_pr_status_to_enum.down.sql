@@ -0,0 +1,6 @@
+-- DRAFT and CLOSED have no smallint counterpart and fall back to OPEN
+alter table pull_requests
+alter column pr_status type smallint
+using (case pr_status when 'MERGED' then 1 else 0 end);
+
+drop type pull_request_status;
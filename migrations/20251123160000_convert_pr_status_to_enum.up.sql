@@ -0,0 +1,5 @@
+create type pull_request_status as enum ('DRAFT', 'OPEN', 'MERGED', 'CLOSED');
+
+alter table pull_requests
+alter column pr_status type pull_request_status
+using (case pr_status when 1 then 'MERGED' else 'OPEN' end)::pull_request_status;
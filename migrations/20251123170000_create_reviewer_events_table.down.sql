@@ -0,0 +1 @@
+drop table reviewer_events;
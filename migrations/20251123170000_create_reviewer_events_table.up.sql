@@ -0,0 +1,19 @@
+create table reviewer_events (
+    id          bigint generated always as identity primary key,
+    pr_id       uuid         not null references pull_requests (
+        id
+    ) on delete cascade,
+    event_type  varchar(32)  not null
+    check (
+        event_type in (
+            'ASSIGNED', 'UNASSIGNED', 'REASSIGNED', 'MERGED', 'DEACTIVATED'
+        )
+    ),
+    user_id     uuid,
+    replaced_by uuid,
+    actor_id    uuid,
+    reason      varchar(255) not null default '',
+    created_at  timestamptz  not null default now()
+);
+
+create index idx_reviewer_events_pr_id on reviewer_events (pr_id, id);
@@ -0,0 +1,11 @@
+-- only sha-256 of a token is stored, the token itself is shown once on issue
+create table api_tokens (
+    id         uuid        not null primary key,
+    token_hash bytea       not null unique,
+    role       varchar(32) not null
+    check (role in ('admin', 'team-lead', 'user')),
+    user_id    uuid references users (id) on delete cascade,
+    created_at timestamptz not null,
+    revoked_at timestamptz,
+    check (role = 'admin' or user_id is not null)
+);
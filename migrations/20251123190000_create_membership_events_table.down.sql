@@ -0,0 +1 @@
+drop table membership_events;
@@ -0,0 +1,11 @@
+create table membership_events (
+    id           bigint generated always as identity primary key,
+    user_id      uuid         not null references users (id) on delete cascade,
+    from_team_id uuid references teams (id) on delete set null,
+    to_team_id   uuid references teams (id) on delete set null,
+    actor_id     uuid,
+    reason       varchar(255) not null default '',
+    created_at   timestamptz  not null default now()
+);
+
+create index idx_membership_events_user_id on membership_events (user_id, id);
@@ -0,0 +1,13 @@
+-- status_code is null while the first request with the key is still served
+create table idempotency_keys (
+    scope           varchar(255) not null,
+    idempotency_key varchar(255) not null,
+    request_hash    bytea        not null,
+    status_code     integer,
+    body            bytea,
+    created_at      timestamptz  not null,
+    expires_at      timestamptz  not null,
+    primary key (scope, idempotency_key)
+);
+
+create index idx_idempotency_keys_expires_at on idempotency_keys (expires_at);
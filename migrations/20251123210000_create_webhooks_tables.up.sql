@@ -0,0 +1,37 @@
+-- secret signs payloads with HMAC-SHA256 and is shown once on creation;
+-- empty event_types subscribes the webhook to every event type
+create table webhooks (
+    id          uuid          not null primary key,
+    url         varchar(2048) not null,
+    secret      varchar(255)  not null,
+    event_types text []       not null default '{}',
+    is_active   boolean       not null default true,
+    created_at  timestamptz   not null default now()
+);
+
+-- outbox: rows are written in the transaction of the change and sent by the
+-- dispatcher, an event committed with the change is never lost. payload is
+-- json, not jsonb, so the document is sent byte for byte as it was built
+create table webhook_deliveries (
+    id              bigint generated always as identity primary key,
+    webhook_id      uuid         not null references webhooks (
+        id
+    ) on delete cascade,
+    event_id        uuid         not null,
+    event_type      varchar(64)  not null,
+    payload         json         not null,
+    status          varchar(16)  not null default 'PENDING'
+    check (status in ('PENDING', 'DELIVERED', 'FAILED')),
+    attempts        integer      not null default 0,
+    next_attempt_at timestamptz  not null default now(),
+    last_error      text         not null default '',
+    created_at      timestamptz  not null default now(),
+    delivered_at    timestamptz
+);
+
+create index idx_webhook_deliveries_due on webhook_deliveries (
+    next_attempt_at
+) where status = 'PENDING';
+create index idx_webhook_deliveries_webhook_id on webhook_deliveries (
+    webhook_id, id
+);
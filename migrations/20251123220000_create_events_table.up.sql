@@ -0,0 +1,13 @@
+-- event feed written in the transaction of the change; payload is json to
+-- keep the document byte for byte
+create table events (
+    id         bigint generated always as identity primary key,
+    tx_id      bigint       not null default txid_current(),
+    event_id   uuid         not null unique,
+    event_type varchar(64)  not null,
+    version    integer      not null,
+    payload    json         not null,
+    created_at timestamptz  not null default now()
+);
+
+create index idx_events_tx_id on events (tx_id, id);
@@ -0,0 +1,2 @@
+alter table idempotency_keys
+drop column locked_until;
@@ -0,0 +1,8 @@
+-- a reservation holds the key only until locked_until, so a request that
+-- crashed before storing its response does not block the key for the whole ttl
+alter table idempotency_keys
+add column locked_until timestamptz;
+
+update idempotency_keys
+set locked_until = now()
+where status_code is null;
@@ -0,0 +1,9 @@
+alter table events
+add column tx_id bigint not null default txid_current();
+
+create index idx_events_tx_id on events (tx_id, id);
+
+drop index idx_events_unsequenced;
+drop index idx_events_position;
+alter table events
+drop column position;
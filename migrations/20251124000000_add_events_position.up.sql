@@ -0,0 +1,18 @@
+-- feed position assigned after commit by the sequencer, null until then
+alter table events
+add column position bigint;
+
+update events e
+set position = ordered.position
+from (
+    select id, row_number() over (order by tx_id, id) as position
+    from events
+) ordered
+where e.id = ordered.id;
+
+create unique index idx_events_position on events (position);
+create index idx_events_unsequenced on events (id) where position is null;
+
+drop index idx_events_tx_id;
+alter table events
+drop column tx_id;
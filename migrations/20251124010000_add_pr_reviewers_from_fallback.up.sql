@@ -0,0 +1,11 @@
+alter table pr_reviewers
+add column from_fallback boolean not null default false;
+
+-- assignments made before the column existed: judged by the current teams
+update pr_reviewers r
+set from_fallback = true
+from pull_requests p, users a, users u
+where p.id = r.pr_id
+  and a.id = p.author_id
+  and u.id = r.user_id
+  and u.team_id is distinct from a.team_id;
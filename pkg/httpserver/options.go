@@ -30,3 +30,11 @@ func ShutdownTimeout(timeout time.Duration) Option {
 		s.shutdownTimeout = timeout
 	}
 }
+
+// OnShutdown registers f to run when shutdown starts, e.g. to end
+// long-lived responses the server would otherwise wait for
+func OnShutdown(f func()) Option {
+	return func(s *Server) {
+		s.server.RegisterOnShutdown(f)
+	}
+}
@@ -0,0 +1,197 @@
+package jwt
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/sync/singleflight"
+)
+
+const defaultMinRefreshInterval = time.Minute
+
+// KeySource resolves a verification key by the kid from the token header
+type KeySource interface {
+	Key(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// KeySet static set of public keys parsed from a JWKS document
+type KeySet struct {
+	keys map[string]crypto.PublicKey
+}
+
+// ParseKeySet reads RSA and P-256 EC keys; keys meant for encryption and
+// unsupported key types are skipped
+func ParseKeySet(data []byte) (*KeySet, error) {
+	var doc struct {
+		Keys []json.RawMessage `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("decode jwks: %w", err)
+	}
+
+	set := &KeySet{keys: make(map[string]crypto.PublicKey, len(doc.Keys))}
+	for _, raw := range doc.Keys {
+		// peek at the key type first: go-jose fails on types it does not
+		// know, while such keys are only skipped here
+		var meta struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			Use string `json:"use"`
+		}
+		if err := json.Unmarshal(raw, &meta); err != nil {
+			return nil, fmt.Errorf("decode jwks: %w", err)
+		}
+		if meta.Use != "" && meta.Use != "sig" {
+			continue
+		}
+		if meta.Kty != "RSA" && meta.Kty != "EC" {
+			continue
+		}
+
+		var k jose.JSONWebKey
+		if err := k.UnmarshalJSON(raw); err != nil {
+			return nil, fmt.Errorf("jwks key %q: %w", meta.Kid, err)
+		}
+		public := k.Public()
+		if !public.Valid() {
+			return nil, fmt.Errorf("jwks key %q: invalid key", meta.Kid)
+		}
+		if ec, ok := public.Key.(*ecdsa.PublicKey); ok && ec.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("jwks key %q: unsupported curve %q", meta.Kid, ec.Curve.Params().Name)
+		}
+		set.keys[k.KeyID] = public.Key
+	}
+	if len(set.keys) == 0 {
+		return nil, fmt.Errorf("jwks has no usable signing keys")
+	}
+	return set, nil
+}
+
+func LoadKeySetFile(path string) (*KeySet, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+	return ParseKeySet(data)
+}
+
+// Key returns the key with the kid; a token without kid matches the only
+// key of a single-key set
+func (s *KeySet) Key(_ context.Context, kid string) (crypto.PublicKey, error) {
+	if key, ok := s.keys[kid]; ok {
+		return key, nil
+	}
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: kid %q", ErrKeyNotFound, kid)
+}
+
+// RemoteKeySet fetches the JWKS from a URL and refetches it when a token
+// references an unknown kid, at most once per refresh interval whether the
+// previous attempt succeeded or not. The fetch runs without holding the
+// lock, and concurrent callers share a single request
+type RemoteKeySet struct {
+	url             string
+	client          *http.Client
+	refreshInterval time.Duration
+	fetches         singleflight.Group
+
+	mu          sync.Mutex
+	set         *KeySet
+	lastAttempt time.Time
+	lastErr     error
+}
+
+func NewRemoteKeySet(url string, client *http.Client) *RemoteKeySet {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &RemoteKeySet{
+		url:             url,
+		client:          client,
+		refreshInterval: defaultMinRefreshInterval,
+	}
+}
+
+func (r *RemoteKeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	set, lastAttempt, lastErr := r.snapshot()
+	if set != nil {
+		if key, err := set.Key(ctx, kid); err == nil {
+			return key, nil
+		}
+	}
+
+	if !lastAttempt.IsZero() && time.Since(lastAttempt) < r.refreshInterval {
+		if set == nil {
+			return nil, lastErr
+		}
+		return set.Key(ctx, kid)
+	}
+
+	if err := r.Refresh(ctx); err != nil {
+		return nil, err
+	}
+	set, _, _ = r.snapshot()
+	return set.Key(ctx, kid)
+}
+
+// Refresh fetches the key set right away, e.g. to fail fast on startup; a
+// failed fetch keeps the previously loaded keys
+func (r *RemoteKeySet) Refresh(ctx context.Context) error {
+	// the fetch is shared, so one caller giving up must not cancel it for
+	// the others; the client timeout still bounds it
+	ctx = context.WithoutCancel(ctx)
+	_, err, _ := r.fetches.Do(r.url, func() (any, error) {
+		set, err := r.fetch(ctx)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		r.lastAttempt = time.Now()
+		r.lastErr = err
+		if err == nil {
+			r.set = set
+		}
+		return nil, err
+	})
+	return err
+}
+
+func (r *RemoteKeySet) snapshot() (*KeySet, time.Time, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.set, r.lastAttempt, r.lastErr
+}
+
+func (r *RemoteKeySet) fetch(ctx context.Context) (*KeySet, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build jwks request: %w", err)
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch jwks: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetch jwks: unexpected status %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read jwks: %w", err)
+	}
+	return ParseKeySet(data)
+}
@@ -0,0 +1,96 @@
+package jwt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	josejwt "github.com/go-jose/go-jose/v4/jwt"
+)
+
+const (
+	AlgRS256 = string(jose.RS256)
+	AlgES256 = string(jose.ES256)
+
+	defaultLeeway = 30 * time.Second
+)
+
+var (
+	ErrInvalidToken = errors.New("invalid jwt")
+	ErrTokenExpired = errors.New("jwt is expired or not yet valid")
+	ErrKeyNotFound  = errors.New("jwt signing key not found")
+)
+
+// signatureAlgorithms the only algorithms a token may be signed with;
+// anything else, "none" and HMAC included, is rejected while parsing
+var signatureAlgorithms = []jose.SignatureAlgorithm{jose.RS256, jose.ES256}
+
+type Verifier struct {
+	keys     KeySource
+	issuer   string
+	audience string
+	leeway   time.Duration
+	now      func() time.Time
+}
+
+func NewVerifier(keys KeySource, opts ...Option) *Verifier {
+	v := &Verifier{
+		keys:   keys,
+		leeway: defaultLeeway,
+		now:    time.Now,
+	}
+	for _, opt := range opts {
+		opt(v)
+	}
+	return v
+}
+
+// IsJWT tells compact JWS tokens apart from opaque ones
+func IsJWT(token string) bool {
+	return strings.Count(token, ".") == 2
+}
+
+// Verify checks the RS256/ES256 signature and exp, nbf, iss and aud, then
+// decodes the payload into claims
+func (v *Verifier) Verify(ctx context.Context, token string, claims any) error {
+	parsed, err := josejwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	key, err := v.keys.Key(ctx, parsed.Headers[0].KeyID)
+	if err != nil {
+		return err
+	}
+
+	var registered josejwt.Claims
+	if err := parsed.Claims(key, &registered, claims); err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	return v.checkClaims(registered)
+}
+
+func (v *Verifier) checkClaims(c josejwt.Claims) error {
+	if c.Expiry == nil {
+		return fmt.Errorf("%w: exp is required", ErrInvalidToken)
+	}
+
+	expected := josejwt.Expected{Issuer: v.issuer, Time: v.now()}
+	if v.audience != "" {
+		expected.AnyAudience = josejwt.Audience{v.audience}
+	}
+	err := c.ValidateWithLeeway(expected, v.leeway)
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, josejwt.ErrExpired),
+		errors.Is(err, josejwt.ErrNotValidYet),
+		errors.Is(err, josejwt.ErrIssuedInTheFuture):
+		return ErrTokenExpired
+	default:
+		return fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+}
@@ -0,0 +1,219 @@
+package integration_test
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/pkg/jwt"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func b64(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]any) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	require.NoError(t, err)
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	signingInput := b64(header) + "." + b64(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+
+	var sig []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+		require.NoError(t, err)
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, digest[:])
+		require.NoError(t, err)
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	return signingInput + "." + b64(sig)
+}
+
+func Test_AuthService_JWT(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.NoError(t, err)
+		ecPoint, err := ecKey.PublicKey.Bytes()
+		require.NoError(t, err)
+
+		jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{
+			{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+			{"kty": "EC", "kid": "ec", "crv": "P-256", "x": b64(ecPoint[1:33]), "y": b64(ecPoint[33:])},
+		}})
+		require.NoError(t, err)
+		keys, err := jwt.ParseKeySet(jwks)
+		require.NoError(t, err)
+
+		verifier := jwt.NewVerifier(keys, jwt.Issuer("sso"), jwt.Audience("reviewers"))
+		authService := service.NewAuthService(newReposFromPool(pool, testDB.Getter), verifier)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "lead", IsActive: true},
+		}
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-jwt", users)
+		leadId := created[0].UserId
+
+		exp := time.Now().Add(time.Hour).Unix()
+		claims := map[string]any{
+			"iss": "sso", "aud": []string{"reviewers"}, "exp": exp,
+			"sub": leadId.String(), "team": "team-jwt", "roles": []string{"user", "team-lead"},
+		}
+
+		// RS256 и ES256 дают одинаковый principal
+		for _, token := range []string{
+			signJWT(t, jwt.AlgRS256, "rsa", rsaKey, claims),
+			signJWT(t, jwt.AlgES256, "ec", ecKey, claims),
+		} {
+			principal, err := authService.Authenticate(ctx, token)
+			require.NoError(t, err)
+			require.Equal(t, domain.RoleTeamLead, principal.Role)
+			require.Equal(t, leadId, *principal.UserId)
+			require.Equal(t, teamId, *principal.TeamId)
+			require.Equal(t, leadId.String(), principal.Subject)
+		}
+
+		// подпись чужим ключом
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		_, err = authService.Authenticate(ctx, signJWT(t, jwt.AlgRS256, "rsa", otherKey, claims))
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		expired := map[string]any{"iss": "sso", "aud": "reviewers", "exp": time.Now().Add(-time.Hour).Unix(), "sub": leadId.String(), "roles": []string{"user"}}
+		_, err = authService.Authenticate(ctx, signJWT(t, jwt.AlgRS256, "rsa", rsaKey, expired))
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		wrongAudience := map[string]any{"iss": "sso", "aud": "other", "exp": exp, "sub": leadId.String(), "roles": []string{"user"}}
+		_, err = authService.Authenticate(ctx, signJWT(t, jwt.AlgRS256, "rsa", rsaKey, wrongAudience))
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		// не-админ должен быть известным пользователем, админ — не обязательно
+		stranger := map[string]any{"iss": "sso", "aud": "reviewers", "exp": exp, "sub": "someone@sso", "roles": []string{"user"}}
+		_, err = authService.Authenticate(ctx, signJWT(t, jwt.AlgRS256, "rsa", rsaKey, stranger))
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		stranger["roles"] = []string{"admin"}
+		principal, err := authService.Authenticate(ctx, signJWT(t, jwt.AlgES256, "ec", ecKey, stranger))
+		require.NoError(t, err)
+		require.Equal(t, domain.RoleAdmin, principal.Role)
+		require.Nil(t, principal.UserId)
+	})
+}
+
+func Test_RemoteKeySet_Refresh(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks, err := json.Marshal(map[string]any{"keys": []map[string]string{
+		{"kty": "RSA", "kid": "rsa", "use": "sig", "n": b64(rsaKey.N.Bytes()), "e": b64(big.NewInt(int64(rsaKey.E)).Bytes())},
+	}})
+	require.NoError(t, err)
+
+	var (
+		requests atomic.Int32
+		failing  atomic.Bool
+		release  = make(chan struct{})
+		blocking atomic.Bool
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if blocking.Load() {
+			<-release
+		}
+		if failing.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = w.Write(jwks)
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+
+	t.Run("неудачная загрузка тоже ограничивает повторные запросы", func(t *testing.T) {
+		requests.Store(0)
+		failing.Store(true)
+		remote := jwt.NewRemoteKeySet(srv.URL, srv.Client())
+
+		_, err := remote.Key(ctx, "rsa")
+		require.Error(t, err)
+		_, err = remote.Key(ctx, "rsa")
+		require.Error(t, err)
+		require.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("неизвестный kid не вызывает повторную загрузку раньше интервала", func(t *testing.T) {
+		requests.Store(0)
+		failing.Store(false)
+		remote := jwt.NewRemoteKeySet(srv.URL, srv.Client())
+
+		_, err := remote.Key(ctx, "rsa")
+		require.NoError(t, err)
+		_, err = remote.Key(ctx, "unknown")
+		require.ErrorIs(t, err, jwt.ErrKeyNotFound)
+		require.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("загрузка не блокирует известные ключи и выполняется один раз", func(t *testing.T) {
+		requests.Store(0)
+		failing.Store(false)
+		remote := jwt.NewRemoteKeySet(srv.URL, srv.Client())
+		require.NoError(t, remote.Refresh(ctx))
+
+		blocking.Store(true)
+		var wg sync.WaitGroup
+		for range 5 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_ = remote.Refresh(ctx)
+			}()
+		}
+		require.Eventually(t, func() bool { return requests.Load() == 2 }, time.Second, 10*time.Millisecond)
+
+		// пока JWKS грузится, уже известный ключ отдаётся без ожидания
+		done := make(chan error, 1)
+		go func() {
+			_, err := remote.Key(ctx, "rsa")
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(time.Second):
+			t.Fatal("Key blocked on the running fetch")
+		}
+
+		blocking.Store(false)
+		close(release)
+		wg.Wait()
+		require.EqualValues(t, 2, requests.Load())
+	})
+}
@@ -0,0 +1,72 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AuthService_IssueAuthenticateRevoke(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		repos := newReposFromPool(pool, testDB.Getter)
+		authService := service.NewAuthService(repos, nil)
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "lead", IsActive: true},
+		}
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-auth", users)
+		leadId := created[0].UserId
+
+		token, apiToken, err := authService.IssueToken(ctx, domain.RoleTeamLead, &leadId)
+		require.NoError(t, err)
+		require.NotEmpty(t, token)
+
+		principal, err := authService.Authenticate(ctx, token)
+		require.NoError(t, err)
+		require.Equal(t, domain.RoleTeamLead, principal.Role)
+		require.Equal(t, leadId, *principal.UserId)
+		require.Equal(t, teamId, *principal.TeamId)
+
+		_, err = authService.Authenticate(ctx, "unknown")
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		// токен неактивного пользователя не принимается
+		_, err = userService.SetIsActive(ctx, leadId, false)
+		require.NoError(t, err)
+		_, err = authService.Authenticate(ctx, token)
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		adminToken, adminAPIToken, err := authService.IssueToken(ctx, domain.RoleAdmin, nil)
+		require.NoError(t, err)
+		principal, err = authService.Authenticate(ctx, adminToken)
+		require.NoError(t, err)
+		require.Equal(t, domain.RoleAdmin, principal.Role)
+		require.Nil(t, principal.TeamId)
+
+		revoked, err := authService.RevokeToken(ctx, adminAPIToken.TokenId)
+		require.NoError(t, err)
+		require.NotNil(t, revoked.RevokedAt)
+		_, err = authService.Authenticate(ctx, adminToken)
+		require.ErrorIs(t, err, service.ErrUnauthorized)
+
+		_, err = authService.RevokeToken(ctx, adminAPIToken.TokenId)
+		require.ErrorIs(t, err, service.ErrNotFound)
+		_, err = authService.RevokeToken(ctx, apiToken.TokenId)
+		require.NoError(t, err)
+
+		_, _, err = authService.IssueToken(ctx, domain.RoleUser, nil)
+		require.ErrorIs(t, err, service.ErrInvalidToken)
+		missing := uuid.New()
+		_, _, err = authService.IssueToken(ctx, domain.RoleUser, &missing)
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,129 @@
+package integration_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"avito-test-applicant/internal/api/adapter/handlers"
+	"avito-test-applicant/internal/api/adapter/middleware"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// brokenEvents fails like a service whose database is gone
+type brokenEvents struct{}
+
+func (brokenEvents) ListEvents(context.Context, *domain.EventCursor, int) (domain.EventPage, error) {
+	return domain.EventPage{}, errors.New("connection refused")
+}
+
+func (brokenEvents) LastCursor(context.Context) (*domain.EventCursor, error) {
+	return nil, errors.New("connection refused")
+}
+
+func newErrorTestServer() *echo.Echo {
+	e := echo.New()
+	e.Validator = middleware.NewRequestValidator()
+	e.Use(middleware.RequestID())
+	e.HTTPErrorHandler = middleware.NewHTTPErrorHandler(logrus.New())
+	server := handlers.NewServer(&service.Services{Event: brokenEvents{}})
+	apigen.RegisterHandlers(e, apigen.NewStrictHandler(server, []apigen.StrictMiddlewareFunc{
+		middleware.Validate(),
+	}))
+	return e
+}
+
+func Test_ErrorHandler_ResponseShapes(t *testing.T) {
+	e := newErrorTestServer()
+
+	cases := []struct {
+		name        string
+		method      string
+		target      string
+		contentType string
+		body        string
+		status      int
+		code        apigen.ErrorResponseErrorCode
+		message     string
+	}{
+		{
+			name:   "invalid uuid",
+			method: http.MethodGet, target: "/users/getReview?user_id=not-a-uuid",
+			status: http.StatusBadRequest, code: apigen.VALIDATIONFAILED, message: "invalid uuid format",
+		},
+		{
+			name:   "empty body",
+			method: http.MethodPost, target: "/pullRequest/review", contentType: echo.MIMEApplicationJSON,
+			status: http.StatusBadRequest, code: apigen.BADREQUEST, message: "request body is empty",
+		},
+		{
+			name:   "malformed json",
+			method: http.MethodPost, target: "/pullRequest/review", contentType: echo.MIMEApplicationJSON, body: "{",
+			status: http.StatusBadRequest, code: apigen.BADREQUEST,
+		},
+		{
+			name:   "validator failure",
+			method: http.MethodPost, target: "/team/mergePolicy", contentType: echo.MIMEApplicationJSON,
+			body:   `{"team_name": "", "required_approvals": 1, "require_lead_approval": false}`,
+			status: http.StatusBadRequest, code: apigen.VALIDATIONFAILED, message: "validation failed: team_name failed on required",
+		},
+		{
+			name:   "unexpected error",
+			method: http.MethodGet, target: "/events",
+			status: http.StatusInternalServerError, code: apigen.INTERNAL, message: "internal server error",
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodPut, target: "/pullRequest/review",
+			status: http.StatusMethodNotAllowed, code: apigen.METHODNOTALLOWED,
+		},
+		{
+			name:   "unsupported media type",
+			method: http.MethodPost, target: "/pullRequest/review", contentType: echo.MIMETextPlain, body: "approve",
+			status: http.StatusUnsupportedMediaType, code: apigen.UNSUPPORTEDMEDIATYPE,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(tc.method, tc.target, strings.NewReader(tc.body))
+			if tc.contentType != "" {
+				req.Header.Set(echo.HeaderContentType, tc.contentType)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			require.Equal(t, tc.status, rec.Code, rec.Body.String())
+
+			var resp apigen.ErrorResponse
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+			require.Equal(t, tc.code, resp.Error.Code)
+			if tc.message != "" {
+				require.Equal(t, tc.message, resp.Error.Message)
+			}
+			// request_id тела совпадает с заголовком ответа
+			require.NotNil(t, resp.Error.RequestId)
+			require.NotEmpty(t, *resp.Error.RequestId)
+			require.Equal(t, rec.Header().Get(echo.HeaderXRequestID), *resp.Error.RequestId)
+		})
+	}
+
+	// присланный клиентом X-Request-ID попадает в ошибку
+	req := httptest.NewRequest(http.MethodGet, "/users/getReview?user_id=not-a-uuid", nil)
+	req.Header.Set(echo.HeaderXRequestID, "req-42")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	var resp apigen.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Equal(t, "req-42", *resp.Error.RequestId)
+}
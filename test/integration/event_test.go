@@ -0,0 +1,298 @@
+package integration_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-applicant/internal/api/adapter"
+	"avito-test-applicant/internal/api/adapter/handlers"
+	"avito-test-applicant/internal/api/adapter/middleware"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/pkg/postgres"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func eventTypes(records []domain.EventRecord) []domain.EventType {
+	types := make([]domain.EventType, len(records))
+	for i, r := range records {
+		types[i] = r.Event.Type
+	}
+	return types
+}
+
+func Test_EventFeed_RecordsChangesInOrder(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		events := service.NewEventService(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool))
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		last, err := events.LastCursor(ctx)
+		require.NoError(t, err)
+		require.Nil(t, last)
+
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-events", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		draft, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "feed", users[0].UserId, true)
+		require.NoError(t, err)
+		prId := draft.PullRequest.PullRequestId
+		_, err = prService.MarkReady(ctx, prId)
+		require.NoError(t, err)
+		_, err = prService.SubmitReview(ctx, prId, users[1].UserId, domain.ReviewStateApproved)
+		require.NoError(t, err)
+		// некому передать ревью: u2 остаётся ревьювером, но деактивируется
+		_, err = userService.SetIsActive(ctx, users[2].UserId, false)
+		require.NoError(t, err)
+		// повторная деактивация ничего не меняет
+		_, err = userService.SetIsActive(ctx, users[2].UserId, false)
+		require.NoError(t, err)
+
+		// отклонённое изменение не оставляет событий
+		_, err = prService.SubmitReview(ctx, prId, users[0].UserId, domain.ReviewStateApproved)
+		require.ErrorIs(t, err, service.ErrNotAssigned)
+
+		first, err := events.ListEvents(ctx, nil, 4)
+		require.NoError(t, err)
+		require.True(t, first.HasMore)
+		require.Len(t, first.Events, 4)
+
+		rest, err := events.ListEvents(ctx, first.NextCursor, 4)
+		require.NoError(t, err)
+		require.False(t, rest.HasMore)
+
+		all := append(first.Events, rest.Events...)
+		require.Equal(t, []domain.EventType{
+			domain.EventPullRequestCreated,
+			domain.EventPullRequestStatusChanged,
+			domain.EventReviewerAssigned,
+			domain.EventReviewerAssigned,
+			domain.EventReviewSubmitted,
+			domain.EventUserDeactivated,
+		}, eventTypes(all))
+
+		ids := make(map[uuid.UUID]struct{})
+		for _, r := range all {
+			require.Equal(t, domain.EventVersion, r.Event.Version)
+			ids[r.Event.Id] = struct{}{}
+		}
+		require.Len(t, ids, len(all))
+
+		changed := all[1].Event.Data
+		require.Equal(t, &prId, changed.PullRequestId)
+		require.Equal(t, domain.PullRequestStatusOPEN, *changed.Status)
+		require.Equal(t, domain.PullRequestStatusDRAFT, *changed.PreviousStatus)
+
+		review := all[4].Event.Data
+		require.Equal(t, &users[1].UserId, review.UserId)
+		require.Equal(t, domain.ReviewStateApproved, *review.ReviewState)
+
+		require.Equal(t, &users[2].UserId, all[5].Event.Data.UserId)
+		require.Nil(t, all[5].Event.Data.PullRequestId)
+
+		// на конце ленты курсор не сдвигается
+		empty, err := events.ListEvents(ctx, rest.NextCursor, 4)
+		require.NoError(t, err)
+		require.Empty(t, empty.Events)
+		require.Equal(t, rest.NextCursor, empty.NextCursor)
+
+		last, err = events.LastCursor(ctx)
+		require.NoError(t, err)
+		require.Equal(t, rest.NextCursor, last)
+	})
+}
+
+func Test_EventFeed_OrdersByCommit(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		events := service.NewEventService(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool))
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-order", []domain.User{
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+
+		// старая транзакция пишет событие, но ещё не зафиксирована
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+		_, err = tx.Exec(ctx,
+			`insert into events (event_id, event_type, version, payload) values ($1, $2, 1, $3)`,
+			uuid.New(), string(domain.EventUserActivated), `{"type":"user.activated"}`,
+		)
+		require.NoError(t, err)
+
+		// более новая транзакция фиксируется первой и сразу видна в ленте
+		_, err = userService.SetIsActive(ctx, users[0].UserId, false)
+		require.NoError(t, err)
+
+		page, err := events.ListEvents(ctx, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.EventType{domain.EventUserDeactivated}, eventTypes(page.Events))
+
+		require.NoError(t, tx.Commit(ctx))
+
+		// событие старой транзакции получает позицию после фиксации и не проскакивает мимо курсора
+		page, err = events.ListEvents(ctx, page.NextCursor, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.EventType{domain.EventUserActivated}, eventTypes(page.Events))
+	})
+}
+
+func Test_EventFeed_NotBlockedByUnrelatedTransaction(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		events := service.NewEventService(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool))
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-stall", []domain.User{
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+
+		// посторонняя транзакция получает номер и остаётся открытой, событий она не пишет
+		tx, err := pool.Begin(ctx)
+		require.NoError(t, err)
+		defer tx.Rollback(ctx)
+		_, err = tx.Exec(ctx, `select txid_current()`)
+		require.NoError(t, err)
+
+		_, err = userService.SetIsActive(ctx, users[0].UserId, false)
+		require.NoError(t, err)
+
+		// зафиксированное событие видно, пока она открыта
+		page, err := events.ListEvents(ctx, nil, 10)
+		require.NoError(t, err)
+		require.Equal(t, []domain.EventType{domain.EventUserDeactivated}, eventTypes(page.Events))
+		last, err := events.LastCursor(ctx)
+		require.NoError(t, err)
+		require.Equal(t, page.NextCursor, last)
+	})
+}
+
+type sseMessage struct {
+	id   string
+	data string
+}
+
+// readSSE returns the next n messages, skipping comments
+func readSSE(t *testing.T, scanner *bufio.Scanner, n int) []sseMessage {
+	t.Helper()
+
+	messages := make([]sseMessage, 0, n)
+	var msg sseMessage
+	for len(messages) < n && scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if msg.data != "" {
+				messages = append(messages, msg)
+			}
+			msg = sseMessage{}
+		case strings.HasPrefix(line, "id: "):
+			msg.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "data: "):
+			msg.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	require.Len(t, messages, n, "stream ended: %v", scanner.Err())
+	return messages
+}
+
+func Test_EventStream_ResumesFromCursor(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		repos := newReposFromPool(pool, testDB.Getter)
+		events := service.NewEventService(repos, postgres.NewTransactionManager(pool))
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		e := echo.New()
+		e.HTTPErrorHandler = middleware.NewHTTPErrorHandler(logrus.New())
+		server := handlers.NewServer(&service.Services{Event: events})
+		apigen.RegisterHandlers(e, apigen.NewStrictHandler(server, nil))
+		httpServer := httptest.NewServer(e)
+		defer httpServer.Close()
+		defer server.CloseStreams()
+
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-stream", []domain.User{
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		for _, u := range users {
+			_, err := userService.SetIsActive(ctx, u.UserId, false)
+			require.NoError(t, err)
+		}
+
+		page, err := events.ListEvents(ctx, nil, 10)
+		require.NoError(t, err)
+		require.Len(t, page.Events, 2)
+		firstCursor := adapter.EncodeEventCursor(page.Events[0].Cursor)
+
+		streamCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+		req, err := http.NewRequestWithContext(streamCtx, http.MethodGet, httpServer.URL+"/events/stream?after="+firstCursor, nil)
+		require.NoError(t, err)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		scanner := bufio.NewScanner(resp.Body)
+		got := readSSE(t, scanner, 1)
+		require.Equal(t, adapter.EncodeEventCursor(page.Events[1].Cursor), got[0].id)
+
+		var event apigen.Event
+		require.NoError(t, json.Unmarshal([]byte(got[0].data), &event))
+		require.Equal(t, apigen.UserDeactivated, event.Type)
+		require.Equal(t, users[1].UserId.String(), *event.Data.UserId)
+
+		// новое событие приходит в открытый поток
+		_, err = userService.SetIsActive(ctx, users[0].UserId, true)
+		require.NoError(t, err)
+		got = readSSE(t, scanner, 1)
+		require.NoError(t, json.Unmarshal([]byte(got[0].data), &event))
+		require.Equal(t, apigen.UserActivated, event.Type)
+		lastId := got[0].id
+		cancel()
+
+		// переподключение с Last-Event-ID продолжает после последнего события
+		_, err = userService.SetIsActive(ctx, users[1].UserId, true)
+		require.NoError(t, err)
+
+		resumeCtx, cancelResume := context.WithTimeout(ctx, 10*time.Second)
+		defer cancelResume()
+		req, err = http.NewRequestWithContext(resumeCtx, http.MethodGet, httpServer.URL+"/events/stream?after="+firstCursor, nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", lastId)
+		resumed, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resumed.Body.Close()
+
+		got = readSSE(t, bufio.NewScanner(resumed.Body), 1)
+		require.NoError(t, json.Unmarshal([]byte(got[0].data), &event))
+		require.Equal(t, apigen.UserActivated, event.Type)
+		require.Equal(t, users[1].UserId.String(), *event.Data.UserId)
+
+		// битый курсор отклоняется до открытия потока
+		bad, err := http.Get(httpServer.URL + "/events/stream?after=not-a-cursor")
+		require.NoError(t, err)
+		defer bad.Body.Close()
+		require.Equal(t, http.StatusBadRequest, bad.StatusCode)
+	})
+}
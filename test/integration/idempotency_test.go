@@ -0,0 +1,195 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"avito-test-applicant/internal/api/adapter/middleware"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/labstack/echo/v4"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_IdempotencyService_ReserveCompleteRelease(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		idempotency := service.NewIdempotencyService(newReposFromPool(pool, testDB.Getter), time.Hour, time.Minute)
+		hash := []byte("request")
+
+		stored, err := idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		// первый запрос ещё выполняется
+		_, err = idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.ErrorIs(t, err, service.ErrIdempotencyKeyInProgress)
+
+		require.NoError(t, idempotency.Complete(ctx, "token:a", "key-1", http.StatusCreated, []byte(`{"ok":true}`)))
+
+		stored, err = idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		require.Equal(t, http.StatusCreated, *stored.StatusCode)
+		require.JSONEq(t, `{"ok":true}`, string(stored.Body))
+
+		_, err = idempotency.Begin(ctx, "token:a", "key-1", []byte("other"))
+		require.ErrorIs(t, err, service.ErrIdempotencyKeyReused)
+
+		// у другого вызывающего свои ключи
+		stored, err = idempotency.Begin(ctx, "token:b", "key-1", hash)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		require.NoError(t, idempotency.Release(ctx, "token:b", "key-1"))
+		stored, err = idempotency.Begin(ctx, "token:b", "key-1", hash)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+	})
+}
+
+func Test_IdempotencyService_ExpiredKeyRunsAgain(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		idempotency := service.NewIdempotencyService(newReposFromPool(pool, testDB.Getter), time.Millisecond, time.Minute)
+
+		_, err := idempotency.Begin(ctx, "token:a", "key-1", []byte("request"))
+		require.NoError(t, err)
+		require.NoError(t, idempotency.Complete(ctx, "token:a", "key-1", http.StatusOK, []byte(`{}`)))
+
+		time.Sleep(10 * time.Millisecond)
+
+		stored, err := idempotency.Begin(ctx, "token:a", "key-1", []byte("request"))
+		require.NoError(t, err)
+		require.Nil(t, stored)
+	})
+}
+
+func Test_IdempotencyService_StaleReservationIsTakenOver(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		idempotency := service.NewIdempotencyService(newReposFromPool(pool, testDB.Getter), time.Hour, 50*time.Millisecond)
+		hash := []byte("request")
+
+		// запрос занял ключ и упал, не сохранив ответ и не освободив ключ
+		stored, err := idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		_, err = idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.ErrorIs(t, err, service.ErrIdempotencyKeyInProgress)
+
+		// после окончания аренды ключ снова можно занять
+		time.Sleep(100 * time.Millisecond)
+		stored, err = idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.NoError(t, err)
+		require.Nil(t, stored)
+
+		// сохранённый ответ аренда не ограничивает
+		require.NoError(t, idempotency.Complete(ctx, "token:a", "key-1", http.StatusOK, []byte(`{}`)))
+		time.Sleep(100 * time.Millisecond)
+		stored, err = idempotency.Begin(ctx, "token:a", "key-1", hash)
+		require.NoError(t, err)
+		require.NotNil(t, stored)
+		require.Equal(t, http.StatusOK, *stored.StatusCode)
+	})
+}
+
+func Test_IdempotencyService_DeleteExpired(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		idempotency := service.NewIdempotencyService(newReposFromPool(pool, testDB.Getter), time.Millisecond, time.Minute)
+
+		for _, key := range []string{"key-1", "key-2"} {
+			_, err := idempotency.Begin(ctx, "token:a", key, []byte("request"))
+			require.NoError(t, err)
+			require.NoError(t, idempotency.Complete(ctx, "token:a", key, http.StatusOK, []byte(`{}`)))
+		}
+
+		time.Sleep(10 * time.Millisecond)
+
+		n, err := idempotency.DeleteExpired(ctx)
+		require.NoError(t, err)
+		require.Equal(t, int64(2), n)
+	})
+}
+
+func Test_IdempotencyMiddleware_ReplaysStoredResponse(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		idempotency := service.NewIdempotencyService(newReposFromPool(pool, testDB.Getter), time.Hour, time.Minute)
+
+		calls := 0
+		fail := false
+		principal := domain.Principal{TokenId: uuid.New(), Role: domain.RoleAdmin}
+
+		e := echo.New()
+		e.HTTPErrorHandler = middleware.NewHTTPErrorHandler(logrus.New())
+		// stands in for Authenticate
+		e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+			return func(c echo.Context) error {
+				c.SetRequest(c.Request().WithContext(domain.WithPrincipal(c.Request().Context(), principal)))
+				return next(c)
+			}
+		})
+		api := e.Group("", middleware.Idempotency(idempotency))
+		api.POST("/pullRequest/reassign", func(c echo.Context) error {
+			calls++
+			if fail {
+				return errors.New("boom")
+			}
+			return c.JSON(http.StatusOK, map[string]any{"call": calls, "reviewer": uuid.NewString()})
+		})
+
+		do := func(key, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/pullRequest/reassign", strings.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			if key != "" {
+				req.Header.Set(middleware.HeaderIdempotencyKey, key)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+			return rec
+		}
+
+		first := do("retry-1", `{"pull_request_id":"1"}`)
+		require.Equal(t, http.StatusOK, first.Code)
+
+		second := do("retry-1", `{"pull_request_id":"1"}`)
+		require.Equal(t, http.StatusOK, second.Code)
+		require.Equal(t, "true", second.Header().Get(middleware.HeaderIdempotencyReplayed))
+		require.JSONEq(t, first.Body.String(), second.Body.String())
+		require.Equal(t, 1, calls)
+
+		reused := do("retry-1", `{"pull_request_id":"2"}`)
+		require.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+		require.Contains(t, reused.Body.String(), "IDEMPOTENCY_KEY_REUSED")
+
+		// без ключа запрос выполняется каждый раз
+		do("", `{"pull_request_id":"1"}`)
+		require.Equal(t, 2, calls)
+
+		// 5xx не сохраняется, повтор выполняет запрос заново
+		fail = true
+		require.Equal(t, http.StatusInternalServerError, do("retry-2", `{}`).Code)
+		fail = false
+		require.Equal(t, http.StatusOK, do("retry-2", `{}`).Code)
+		require.Equal(t, 4, calls)
+
+		// тело с ключом не читается в память целиком сверх лимита
+		tooLarge := do("retry-3", `{"pull_request_id":"`+strings.Repeat("x", 1<<20)+`"}`)
+		require.Equal(t, http.StatusRequestEntityTooLarge, tooLarge.Code)
+		require.Contains(t, tooLarge.Body.String(), "PAYLOAD_TOO_LARGE")
+		require.Equal(t, 4, calls)
+	})
+}
@@ -0,0 +1,201 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	"avito-test-applicant/internal/api/adapter/handlers"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PullRequestAccess_CreateOnlyAsAuthor(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		server := handlers.NewServer(&service.Services{
+			PullRequest: newPRServiceFromPool(pool, testDB.Getter),
+			User:        newUserServiceFromPool(pool, testDB.Getter),
+		})
+
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-create-access", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		author := created[0].UserId
+		other := created[1].UserId
+
+		create := func() apigen.PostPullRequestCreateRequestObject {
+			return apigen.PostPullRequestCreateRequestObject{Body: &apigen.PostPullRequestCreateJSONRequestBody{
+				PullRequestId:   uuid.NewString(),
+				PullRequestName: "access",
+				AuthorId:        author.String(),
+			}}
+		}
+
+		// PR создаётся только от своего имени, даже админом или лидом команды
+		foreign := []domain.Principal{
+			{Role: domain.RoleAdmin},
+			{Role: domain.RoleTeamLead, UserId: &other, TeamId: &teamId},
+			{Role: domain.RoleUser, UserId: &other, TeamId: &teamId},
+		}
+		for _, p := range foreign {
+			_, err := server.PostPullRequestCreate(domain.WithPrincipal(ctx, p), create())
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+		}
+
+		self := domain.WithPrincipal(ctx, domain.Principal{Role: domain.RoleUser, UserId: &author, TeamId: &teamId})
+		resp, err := server.PostPullRequestCreate(self, create())
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestCreate201JSONResponse{}, resp)
+	})
+}
+
+func Test_PullRequestAccess_OnlyAuthorTeam(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		server := handlers.NewServer(&service.Services{
+			PullRequest: prService,
+			User:        newUserServiceFromPool(pool, testDB.Getter),
+		})
+
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-pr-access", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+		})
+		otherTeamId, strangers := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-pr-access-other", []domain.User{
+			{UserId: uuid.New(), Username: "stranger", IsActive: true},
+		})
+		member := created[3].UserId
+		stranger := strangers[0].UserId
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "access", created[0].UserId, false)
+		require.NoError(t, err)
+		prId := pr.PullRequest.PullRequestId.String()
+		closeReq := apigen.PostPullRequestCloseRequestObject{Body: &apigen.PostPullRequestCloseJSONRequestBody{PullRequestId: prId}}
+		reopenReq := apigen.PostPullRequestReopenRequestObject{Body: &apigen.PostPullRequestReopenJSONRequestBody{PullRequestId: prId}}
+
+		// участники чужой команды не трогают PR, даже её лид
+		for _, p := range []domain.Principal{
+			{Role: domain.RoleUser, UserId: &stranger, TeamId: &otherTeamId},
+			{Role: domain.RoleTeamLead, UserId: &stranger, TeamId: &otherTeamId},
+		} {
+			asCaller := domain.WithPrincipal(ctx, p)
+			_, err := server.PostPullRequestMerge(asCaller, apigen.PostPullRequestMergeRequestObject{
+				Body: &apigen.PostPullRequestMergeJSONRequestBody{PullRequestId: prId},
+			})
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+			_, err = server.PostPullRequestClose(asCaller, closeReq)
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+			_, err = server.PostPullRequestReopen(asCaller, reopenReq)
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+			_, err = server.PostPullRequestReady(asCaller, apigen.PostPullRequestReadyRequestObject{
+				Body: &apigen.PostPullRequestReadyJSONRequestBody{PullRequestId: prId},
+			})
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+			_, err = server.PostPullRequestReassign(asCaller, apigen.PostPullRequestReassignRequestObject{
+				Body: &apigen.PostPullRequestReassignJSONRequestBody{PullRequestId: prId, OldUserId: pr.Reviewers[0].String()},
+			})
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+		}
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusOPEN, got.PullRequest.Status)
+
+		// участник команды автора и админ работают с PR
+		asMember := domain.WithPrincipal(ctx, domain.Principal{Role: domain.RoleUser, UserId: &member, TeamId: &teamId})
+		closed, err := server.PostPullRequestClose(asMember, closeReq)
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestClose200JSONResponse{}, closed)
+
+		asAdmin := domain.WithPrincipal(ctx, domain.Principal{Role: domain.RoleAdmin})
+		reopened, err := server.PostPullRequestReopen(asAdmin, reopenReq)
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestReopen200JSONResponse{}, reopened)
+	})
+}
+
+func Test_Access_UnknownResourcesAreNotFound(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		server := handlers.NewServer(&service.Services{
+			PullRequest: newPRServiceFromPool(pool, testDB.Getter),
+			User:        newUserServiceFromPool(pool, testDB.Getter),
+			Team:        newTeamServiceFromPool(pool, testDB.Getter),
+		})
+
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-unknown-access", []domain.User{
+			{UserId: uuid.New(), Username: "lead", IsActive: true},
+		})
+		lead := created[0].UserId
+		// доступ к неизвестному ресурсу не проверить, поэтому ответ — 404, а не 403
+		asLead := domain.WithPrincipal(ctx, domain.Principal{Role: domain.RoleTeamLead, UserId: &lead, TeamId: &teamId})
+
+		unknownPR := uuid.NewString()
+		merged, err := server.PostPullRequestMerge(asLead, apigen.PostPullRequestMergeRequestObject{
+			Body: &apigen.PostPullRequestMergeJSONRequestBody{PullRequestId: unknownPR},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestMerge404JSONResponse{}, merged)
+
+		closed, err := server.PostPullRequestClose(asLead, apigen.PostPullRequestCloseRequestObject{
+			Body: &apigen.PostPullRequestCloseJSONRequestBody{PullRequestId: unknownPR},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestClose404JSONResponse{}, closed)
+
+		reopened, err := server.PostPullRequestReopen(asLead, apigen.PostPullRequestReopenRequestObject{
+			Body: &apigen.PostPullRequestReopenJSONRequestBody{PullRequestId: unknownPR},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestReopen404JSONResponse{}, reopened)
+
+		ready, err := server.PostPullRequestReady(asLead, apigen.PostPullRequestReadyRequestObject{
+			Body: &apigen.PostPullRequestReadyJSONRequestBody{PullRequestId: unknownPR},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestReady404JSONResponse{}, ready)
+
+		reassigned, err := server.PostPullRequestReassign(asLead, apigen.PostPullRequestReassignRequestObject{
+			Body: &apigen.PostPullRequestReassignJSONRequestBody{PullRequestId: unknownPR, OldUserId: lead.String()},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestReassign404JSONResponse{}, reassigned)
+
+		added, err := server.PostPullRequestAddReviewer(asLead, apigen.PostPullRequestAddReviewerRequestObject{
+			Body: &apigen.PostPullRequestAddReviewerJSONRequestBody{PullRequestId: unknownPR, UserId: lead.String()},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestAddReviewer404JSONResponse{}, added)
+
+		settings, err := server.PostTeamSettings(asLead, apigen.PostTeamSettingsRequestObject{
+			Body: &apigen.PostTeamSettingsJSONRequestBody{TeamName: "no-such-team", SelectionStrategy: apigen.SelectionStrategy(domain.SelectionStrategyRandom)},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostTeamSettings404JSONResponse{}, settings)
+
+		unknownUser := uuid.NewString()
+		deactivated, err := server.PostUsersSetIsActive(asLead, apigen.PostUsersSetIsActiveRequestObject{
+			Body: &apigen.PostUsersSetIsActiveJSONRequestBody{UserId: unknownUser},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostUsersSetIsActive404JSONResponse{}, deactivated)
+
+		history, err := server.GetUsersTeamHistory(asLead, apigen.GetUsersTeamHistoryRequestObject{
+			Params: apigen.GetUsersTeamHistoryParams{UserId: unknownUser},
+		})
+		require.NoError(t, err)
+		require.IsType(t, apigen.GetUsersTeamHistory404JSONResponse{}, history)
+	})
+}
@@ -33,12 +33,12 @@ func TestPullRequestRepo_CreateAndGet(t *testing.T) {
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-create")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-create", domain.DefaultReviewersRequired)
 		author, _ := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
 
 		prId := uuid.New()
 		prName := "PR 1"
-		pr, err := prRepo.CreatePullRequest(ctx, prId, prName, author.UserId)
+		pr, err := prRepo.CreatePullRequest(ctx, prId, prName, author.UserId, domain.PullRequestStatusOPEN)
 		require.NoError(t, err)
 		require.Equal(t, prId, pr.PullRequestId)
 		require.Equal(t, prName, pr.PullRequestName)
@@ -57,13 +57,13 @@ func TestPullRequestRepo_CreateAndGetByIds(t *testing.T) {
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-multi")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-multi", domain.DefaultReviewersRequired)
 		author, _ := userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
 
 		prs := make([]domain.PullRequest, 0, 3)
 		ids := make([]uuid.UUID, 0, 3)
 		for i := 1; i <= 3; i++ {
-			pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR "+string(rune(i+'A'-1)), author.UserId)
+			pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR "+string(rune(i+'A'-1)), author.UserId, domain.PullRequestStatusOPEN)
 			prs = append(prs, pr)
 			ids = append(ids, pr.PullRequestId)
 		}
@@ -90,10 +90,10 @@ func TestPullRequestRepo_SetMerged(t *testing.T) {
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-merged")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-merged", domain.DefaultReviewersRequired)
 		author, _ := userRepo.CreateUser(ctx, uuid.New(), "charlie", true, team.TeamId)
 
-		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR Merge", author.UserId)
+		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR Merge", author.UserId, domain.PullRequestStatusOPEN)
 		require.Equal(t, domain.PullRequestStatusOPEN, pr.Status)
 
 		merged, err := prRepo.SetMerged(ctx, pr.PullRequestId)
@@ -131,15 +131,71 @@ func TestPullRequestRepo_CreateAlreadyExists(t *testing.T) {
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-duplicate")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-duplicate", domain.DefaultReviewersRequired)
 		author, _ := userRepo.CreateUser(ctx, uuid.New(), "dave", true, team.TeamId)
 
 		prId := uuid.New()
-		_, err := prRepo.CreatePullRequest(ctx, prId, "PR Original", author.UserId)
+		_, err := prRepo.CreatePullRequest(ctx, prId, "PR Original", author.UserId, domain.PullRequestStatusOPEN)
 		require.NoError(t, err)
 
 		// Повторная попытка с тем же ID
-		_, err = prRepo.CreatePullRequest(ctx, prId, "PR Duplicate", author.UserId)
+		_, err = prRepo.CreatePullRequest(ctx, prId, "PR Duplicate", author.UserId, domain.PullRequestStatusOPEN)
 		require.ErrorIs(t, err, repoerrors.ErrAlreadyExists)
 	})
 }
+
+func TestPullRequestRepo_ListPullRequests_KeysetPagination(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		userRepo := newUserRepoFromPool(pool, testDB.Getter)
+		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
+		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
+
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-pr-list", domain.DefaultReviewersRequired)
+		author, _ := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
+		reviewer, _ := userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
+
+		created := make([]uuid.UUID, 0, 5)
+		for i := 0; i < 5; i++ {
+			pr, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR", author.UserId, domain.PullRequestStatusOPEN)
+			require.NoError(t, err)
+			created = append(created, pr.PullRequestId)
+		}
+		require.NoError(t, reviewerRepo.AssignOne(ctx, created[0], reviewer.UserId))
+		_, err := prRepo.SetMerged(ctx, created[1])
+		require.NoError(t, err)
+
+		// обходим все PR страницами по 2 в порядке возрастания
+		var seen []uuid.UUID
+		filter := domain.PullRequestFilter{Order: domain.SortOrderAsc, Limit: 2}
+		for {
+			page, err := prRepo.ListPullRequests(ctx, filter)
+			require.NoError(t, err)
+			for _, pr := range page {
+				seen = append(seen, pr.PullRequestId)
+			}
+			if len(page) < filter.Limit {
+				break
+			}
+			last := page[len(page)-1]
+			filter.After = &domain.PullRequestCursor{CreatedAt: *last.CreatedAt, PullRequestId: last.PullRequestId}
+		}
+		require.ElementsMatch(t, created, seen)
+		require.Len(t, seen, len(created))
+
+		merged := domain.PullRequestStatusMERGED
+		page, err := prRepo.ListPullRequests(ctx, domain.PullRequestFilter{Status: &merged, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, created[1], page[0].PullRequestId)
+
+		page, err = prRepo.ListPullRequests(ctx, domain.PullRequestFilter{ReviewerId: &reviewer.UserId, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page, 1)
+		require.Equal(t, created[0], page[0].PullRequestId)
+
+		page, err = prRepo.ListPullRequests(ctx, domain.PullRequestFilter{TeamId: &team.TeamId, AuthorId: &author.UserId, Limit: 10})
+		require.NoError(t, err)
+		require.Len(t, page, 5)
+	})
+}
@@ -2,6 +2,7 @@ package integration_test
 
 import (
 	"context"
+	"sort"
 	"testing"
 
 	"avito-test-applicant/internal/domain"
@@ -29,21 +30,56 @@ func newReposFromPool(pool *pgxpool.Pool, getter *trmpgx.CtxGetter) *repo.Reposi
 	userRepo := pgdb.NewUserRepo(pg, getter)
 	prRepo := pgdb.NewPullRequestRepo(pg, getter)
 	reviewerRepo := pgdb.NewReviewerRepo(pg, getter)
+	teamSettingsRepo := pgdb.NewTeamSettingsRepo(pg, getter)
+	mergePolicyRepo := pgdb.NewTeamMergePolicyRepo(pg, getter)
+	statsRepo := pgdb.NewStatsRepo(pg, getter)
+	reviewerEventRepo := pgdb.NewReviewerEventRepo(pg, getter)
+	membershipEventRepo := pgdb.NewMembershipEventRepo(pg, getter)
+	apiTokenRepo := pgdb.NewAPITokenRepo(pg, getter)
+	idempotencyKeyRepo := pgdb.NewIdempotencyKeyRepo(pg, getter)
+	webhookRepo := pgdb.NewWebhookRepo(pg, getter)
+	webhookDeliveryRepo := pgdb.NewWebhookDeliveryRepo(pg, getter)
+	eventRepo := pgdb.NewEventRepo(pg, getter)
 
 	return &repo.Repositories{
-		Team:        teamRepo,
-		User:        userRepo,
-		PullRequest: prRepo,
-		Reviewer:    reviewerRepo,
+		Team:            teamRepo,
+		User:            userRepo,
+		PullRequest:     prRepo,
+		Reviewer:        reviewerRepo,
+		TeamSettings:    teamSettingsRepo,
+		TeamMergePolicy: mergePolicyRepo,
+		Stats:           statsRepo,
+		ReviewerEvent:   reviewerEventRepo,
+		MembershipEvent: membershipEventRepo,
+		APIToken:        apiTokenRepo,
+		IdempotencyKey:  idempotencyKeyRepo,
+		Webhook:         webhookRepo,
+		WebhookDelivery: webhookDeliveryRepo,
+		Event:           eventRepo,
 	}
 }
 
 func newPRServiceFromPool(pool *pgxpool.Pool, getter *trmpgx.CtxGetter) *service.PullRequestService {
+	return newPRServiceWithStrategy(pool, getter, domain.SelectionStrategyLeastLoaded, service.NewTimeRandSource())
+}
+
+func newPRServiceWithStrategy(
+	pool *pgxpool.Pool,
+	getter *trmpgx.CtxGetter,
+	strategy domain.SelectionStrategy,
+	randSource service.RandSource,
+) *service.PullRequestService {
 	repos := newReposFromPool(pool, getter)
 
 	trManager := postgres.NewTransactionManager(pool)
 
-	return service.NewPullRequestService(repos, trManager)
+	return service.NewPullRequestService(
+		repos,
+		trManager,
+		service.NewReviewerSelectors(repos),
+		strategy,
+		randSource,
+	)
 }
 
 // helper to create team + users in one function
@@ -51,7 +87,7 @@ func setupTeamWithUsers(ctx context.Context, t *testing.T, pool *pgxpool.Pool, g
 	teamRepo := pgdb.NewTeamRepo(&postgres.Postgres{Pool: pool, Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}, getter)
 	userRepo := pgdb.NewUserRepo(&postgres.Postgres{Pool: pool, Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar)}, getter)
 
-	team, err := teamRepo.CreateTeam(ctx, uuid.New(), teamName)
+	team, err := teamRepo.CreateTeam(ctx, uuid.New(), teamName, domain.DefaultReviewersRequired)
 	require.NoError(t, err)
 
 	created := make([]domain.User, 0, len(users))
@@ -92,7 +128,7 @@ func Test_CreateAndAssign_PrimaryCase_TwoReviewers(t *testing.T) {
 		require.NotEqual(t, uuid.Nil, authorId)
 
 		prID := uuid.New()
-		res, err := service.CreateAndAssignPullRequest(ctx, prID, "add feature", authorId)
+		res, err := service.CreateAndAssignPullRequest(ctx, prID, "add feature", authorId, false)
 		require.NoError(t, err)
 		require.Equal(t, prID, res.PullRequest.PullRequestId)
 		// up to 2 reviewers
@@ -136,7 +172,7 @@ func Test_CreateAndAssign_OneCandidate(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		res, err := service.CreateAndAssignPullRequest(ctx, prID, "small pr", authorId)
+		res, err := service.CreateAndAssignPullRequest(ctx, prID, "small pr", authorId, false)
 		require.NoError(t, err)
 		require.Equal(t, 1, len(res.Reviewers))
 		require.NotEqual(t, authorId, res.Reviewers[0])
@@ -161,7 +197,7 @@ func Test_CreateAndAssign_NoCandidates(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		res, err := service.CreateAndAssignPullRequest(ctx, prID, "no candidates pr", authorId)
+		res, err := service.CreateAndAssignPullRequest(ctx, prID, "no candidates pr", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, res.Reviewers, 0)
 	})
@@ -187,7 +223,7 @@ func Test_CreateAndAssign_AllInactive(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		res, err := service.CreateAndAssignPullRequest(ctx, prID, "inactive pr", authorId)
+		res, err := service.CreateAndAssignPullRequest(ctx, prID, "inactive pr", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, res.Reviewers, 0)
 	})
@@ -214,7 +250,7 @@ func Test_CreateAndAssign_MixedActiveInactive(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		res, err := service.CreateAndAssignPullRequest(ctx, prID, "mixed pr", authorId)
+		res, err := service.CreateAndAssignPullRequest(ctx, prID, "mixed pr", authorId, false)
 		require.NoError(t, err)
 		// reviewers should be from active ones {a,c}, count <=2
 		require.LessOrEqual(t, len(res.Reviewers), 2)
@@ -251,10 +287,201 @@ func Test_CreateAndAssign_DuplicateId(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "dup pr", authorId)
+		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "dup pr", authorId, false)
 		require.NoError(t, err)
 
-		_, err = prService.CreateAndAssignPullRequest(ctx, prID, "dup pr second", authorId)
+		_, err = prService.CreateAndAssignPullRequest(ctx, prID, "dup pr second", authorId, false)
 		require.ErrorIs(t, err, service.ErrPullRequestExists)
 	})
 }
+
+func Test_CreateAndAssign_LeastLoadedPrefersIdleReviewers(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
+		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "busy", IsActive: true},
+			{UserId: uuid.New(), Username: "medium", IsActive: true},
+			{UserId: uuid.New(), Username: "idle", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-load", users)
+		byName := make(map[string]uuid.UUID, len(created))
+		for _, u := range created {
+			byName[u.Username] = u.UserId
+		}
+
+		// busy: 2 open reviews, medium: 1, idle: 0
+		for _, reviewer := range []uuid.UUID{byName["busy"], byName["busy"], byName["medium"]} {
+			pr, err := prRepo.CreatePullRequest(ctx, uuid.New(), "existing pr", byName["author"], domain.PullRequestStatusOPEN)
+			require.NoError(t, err)
+			require.NoError(t, reviewerRepo.AssignOne(ctx, pr.PullRequestId, reviewer))
+		}
+
+		res, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "balanced pr", byName["author"], false)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{byName["idle"], byName["medium"]}, res.Reviewers)
+	})
+}
+
+func Test_CreateAndAssign_UsesTeamReviewersRequired(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "a", IsActive: true},
+			{UserId: uuid.New(), Username: "b", IsActive: true},
+			{UserId: uuid.New(), Username: "c", IsActive: true},
+			{UserId: uuid.New(), Username: "d", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-security", users)
+		var authorId uuid.UUID
+		for _, u := range created {
+			if u.Username == "author" {
+				authorId = u.UserId
+			}
+		}
+
+		three, one := 3, 1
+		_, err := teamService.UpdateTeamSettings(ctx, "team-security", domain.SelectionStrategyLeastLoaded, &three)
+		require.NoError(t, err)
+
+		security, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "security pr", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, security.Reviewers, 3)
+
+		_, err = teamService.UpdateTeamSettings(ctx, "team-security", domain.SelectionStrategyLeastLoaded, &one)
+		require.NoError(t, err)
+
+		res, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "small pr", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, res.Reviewers, 1)
+
+		// переназначение на уже открытом PR не подгоняет его под новое значение
+		reassigned, err := prService.Reassign(ctx, security.PullRequest.PullRequestId, security.Reviewers[0], nil)
+		require.NoError(t, err)
+		require.Len(t, reassigned.Reviewers, 3)
+		require.NotContains(t, reassigned.Reviewers, security.Reviewers[0])
+	})
+}
+
+func Test_CreateAndAssign_SeededFromPullRequestIdIsReproducible(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		randSource := service.NewPullRequestRandSource(42)
+		prService := newPRServiceWithStrategy(pool, testDB.Getter, domain.SelectionStrategyRandom, randSource)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "a", IsActive: true},
+			{UserId: uuid.New(), Username: "b", IsActive: true},
+			{UserId: uuid.New(), Username: "c", IsActive: true},
+			{UserId: uuid.New(), Username: "d", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-seeded", users)
+		var authorId uuid.UUID
+		candidates := make([]uuid.UUID, 0, len(created))
+		for _, u := range created {
+			if u.Username == "author" {
+				authorId = u.UserId
+				continue
+			}
+			candidates = append(candidates, u.UserId)
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			return candidates[i].String() < candidates[j].String()
+		})
+
+		prID := uuid.New()
+		expected, err := service.NewRandomSelector().Select(ctx, randSource.ForPullRequest(prID), candidates, 2)
+		require.NoError(t, err)
+
+		res, err := prService.CreateAndAssignPullRequest(ctx, prID, "seeded pr", authorId, false)
+		require.NoError(t, err)
+		require.Equal(t, expected, res.Reviewers)
+	})
+}
+
+func Test_CreateAndAssign_FallbackTeamWhenHomeTeamExhausted(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+
+		_, home := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-home", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "teammate", IsActive: true},
+		})
+		_, partner := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-partner", []domain.User{
+			{UserId: uuid.New(), Username: "p1", IsActive: true},
+			{UserId: uuid.New(), Username: "p2", IsActive: true},
+		})
+		var authorId, teammateId uuid.UUID
+		for _, u := range home {
+			if u.Username == "author" {
+				authorId = u.UserId
+			} else {
+				teammateId = u.UserId
+			}
+		}
+		partnerIds := []uuid.UUID{partner[0].UserId, partner[1].UserId}
+
+		_, err := teamService.SetFallbackTeams(ctx, "team-home", []string{"team-partner"})
+		require.NoError(t, err)
+
+		// в своей команде только один кандидат, второй берётся из резервной
+		prID := uuid.New()
+		res, err := prService.CreateAndAssignPullRequest(ctx, prID, "needs help", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, res.Reviewers, 2)
+		require.Contains(t, res.Reviewers, teammateId)
+		require.Len(t, res.FallbackReviewers, 1)
+		require.Contains(t, partnerIds, res.FallbackReviewers[0])
+
+		// при переназначении единственного ревьювера своей команды замена тоже из резервной
+		reassigned, err := prService.Reassign(ctx, prID, teammateId, nil)
+		require.NoError(t, err)
+		require.ElementsMatch(t, partnerIds, reassigned.Reviewers)
+		require.ElementsMatch(t, partnerIds, reassigned.FallbackReviewers)
+
+		// происхождение ревьювера фиксируется при назначении и не зависит от
+		// последующих переводов автора
+		_, err = teamService.MoveUser(ctx, authorId, "team-partner")
+		require.NoError(t, err)
+		got, err := prService.GetPullRequestById(ctx, prID)
+		require.NoError(t, err)
+		require.ElementsMatch(t, partnerIds, got.FallbackReviewers)
+	})
+}
+
+func Test_GetPullRequestById_ReturnsReviewers(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-get", users)
+
+		pr, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "get me", created[0].UserId, false)
+		require.NoError(t, err)
+
+		got, err := svc.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.Equal(t, pr.PullRequest.PullRequestId, got.PullRequest.PullRequestId)
+		require.NotNil(t, got.PullRequest.CreatedAt)
+		require.ElementsMatch(t, pr.Reviewers, got.Reviewers)
+
+		_, err = svc.GetPullRequestById(ctx, uuid.New())
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
@@ -0,0 +1,77 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_GetHistory_RecordsEveryAssignmentChange(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+			{UserId: uuid.New(), Username: "u4", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-history", users)
+		authorId := created[0].UserId
+
+		pr, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "history", authorId, false)
+		require.NoError(t, err)
+		prId := pr.PullRequest.PullRequestId
+
+		// действия от имени автора
+		actorCtx := domain.WithActor(ctx, authorId)
+		reassigned, err := svc.Reassign(actorCtx, prId, pr.Reviewers[0], nil)
+		require.NoError(t, err)
+
+		_, err = userService.SetIsActive(ctx, reassigned.ReplacedBy, false)
+		require.NoError(t, err)
+
+		_, err = svc.RemoveReviewer(actorCtx, prId, pr.Reviewers[1])
+		require.NoError(t, err)
+
+		_, err = svc.SetMerged(actorCtx, prId, false)
+		require.NoError(t, err)
+
+		events, err := svc.GetHistory(ctx, prId)
+		require.NoError(t, err)
+
+		types := make([]domain.ReviewerEventType, len(events))
+		for i, e := range events {
+			types[i] = e.Type
+		}
+		require.Equal(t, []domain.ReviewerEventType{
+			domain.ReviewerEventAssigned,
+			domain.ReviewerEventAssigned,
+			domain.ReviewerEventReassigned,
+			domain.ReviewerEventDeactivated,
+			domain.ReviewerEventUnassigned,
+			domain.ReviewerEventMerged,
+		}, types)
+
+		require.Nil(t, events[0].ActorId)
+		require.Equal(t, pr.Reviewers[0], *events[2].UserId)
+		require.Equal(t, reassigned.ReplacedBy, *events[2].ReplacedBy)
+		require.Equal(t, authorId, *events[2].ActorId)
+		require.Equal(t, reassigned.ReplacedBy, *events[3].UserId)
+		require.NotNil(t, events[3].ReplacedBy)
+		require.Nil(t, events[5].UserId)
+
+		_, err = svc.GetHistory(ctx, uuid.New())
+		require.ErrorIs(t, err, service.ErrPullRequestNotFound)
+	})
+}
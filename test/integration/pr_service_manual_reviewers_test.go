@@ -0,0 +1,80 @@
+package integration_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AddRemoveReviewer_EnforcesInvariants(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+			{UserId: uuid.New(), Username: "inactive", IsActive: false},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-manual", users)
+		_, others := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-manual-other", []domain.User{
+			{UserId: uuid.New(), Username: "outsider", IsActive: true},
+		})
+		authorId, inactiveId, outsiderId := created[0].UserId, created[4].UserId, others[0].UserId
+
+		pr, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "manual", authorId, false)
+		require.NoError(t, err)
+		prId := pr.PullRequest.PullRequestId
+		require.Len(t, pr.Reviewers, 2)
+
+		var free uuid.UUID
+		for _, u := range created[1:4] {
+			if !slices.Contains(pr.Reviewers, u.UserId) {
+				free = u.UserId
+			}
+		}
+
+		// лимит reviewers_required = 2 уже достигнут
+		_, err = svc.AddReviewer(ctx, prId, free)
+		require.ErrorIs(t, err, service.ErrTooManyReviewers)
+
+		removed := pr.Reviewers[0]
+		res, err := svc.RemoveReviewer(ctx, prId, removed)
+		require.NoError(t, err)
+		require.NotContains(t, res.Reviewers, removed)
+
+		_, err = svc.RemoveReviewer(ctx, prId, removed)
+		require.ErrorIs(t, err, service.ErrNotAssigned)
+
+		_, err = svc.AddReviewer(ctx, prId, authorId)
+		require.ErrorIs(t, err, service.ErrInvalidReviewer)
+		_, err = svc.AddReviewer(ctx, prId, inactiveId)
+		require.ErrorIs(t, err, service.ErrInvalidReviewer)
+		_, err = svc.AddReviewer(ctx, prId, outsiderId)
+		require.ErrorIs(t, err, service.ErrInvalidReviewer)
+		_, err = svc.AddReviewer(ctx, prId, pr.Reviewers[1])
+		require.ErrorIs(t, err, service.ErrAlreadyAssigned)
+		_, err = svc.AddReviewer(ctx, prId, uuid.New())
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+
+		res, err = svc.AddReviewer(ctx, prId, free)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{pr.Reviewers[1], free}, res.Reviewers)
+
+		_, err = svc.SetMerged(ctx, prId, false)
+		require.NoError(t, err)
+
+		_, err = svc.RemoveReviewer(ctx, prId, free)
+		require.ErrorIs(t, err, service.ErrPullRequestMerged)
+	})
+}
@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetMerged_RespectsMergePolicy(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-merge-policy", users)
+
+		// без политики мерж не ограничен
+		policy, err := teamService.GetMergePolicy(ctx, "team-merge-policy")
+		require.NoError(t, err)
+		require.Zero(t, policy.RequiredApprovals)
+		require.False(t, policy.RequireLeadApproval)
+
+		_, err = teamService.UpdateMergePolicy(ctx, "team-merge-policy", domain.MergePolicy{RequireLeadApproval: true})
+		require.ErrorIs(t, err, service.ErrInvalidMergePolicy)
+
+		_, err = teamService.UpdateMergePolicy(ctx, "team-merge-policy", domain.MergePolicy{RequiredApprovals: 2})
+		require.NoError(t, err)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "gated", created[0].UserId, false)
+		require.NoError(t, err)
+		prId := pr.PullRequest.PullRequestId
+
+		_, err = prService.SubmitReview(ctx, prId, pr.Reviewers[0], domain.ReviewStateApproved)
+		require.NoError(t, err)
+
+		_, err = prService.SetMerged(ctx, prId, false)
+		require.ErrorIs(t, err, service.ErrNotApproved)
+
+		_, err = prService.SubmitReview(ctx, prId, pr.Reviewers[1], domain.ReviewStateApproved)
+		require.NoError(t, err)
+
+		merged, err := prService.SetMerged(ctx, prId, false)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusMERGED, merged.PullRequest.Status)
+	})
+}
+
+func Test_SetMerged_LeadApprovalAndForce(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-merge-lead", users)
+		lead := created[1].UserId
+
+		_, err := teamService.UpdateMergePolicy(ctx, "team-merge-lead", domain.MergePolicy{
+			RequireLeadApproval: true,
+			LeadUserId:          &lead,
+		})
+		require.NoError(t, err)
+
+		outsider := uuid.New()
+		_, err = teamService.UpdateMergePolicy(ctx, "team-merge-lead", domain.MergePolicy{
+			RequireLeadApproval: true,
+			LeadUserId:          &outsider,
+		})
+		require.ErrorIs(t, err, service.ErrUserNotInTeam)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "needs lead", created[0].UserId, false)
+		require.NoError(t, err)
+
+		_, err = prService.SetMerged(ctx, pr.PullRequest.PullRequestId, false)
+		require.ErrorIs(t, err, service.ErrNotApproved)
+
+		// force обходит политику
+		merged, err := prService.SetMerged(ctx, pr.PullRequest.PullRequestId, true)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusMERGED, merged.PullRequest.Status)
+	})
+}
+
+func Test_SubmitReview_LeadApprovesWithoutAssignment(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-merge-free-lead", users)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "lead not picked", created[0].UserId, false)
+		require.NoError(t, err)
+		prId := pr.PullRequest.PullRequestId
+
+		// лидом становится тот, кого выбор ревьюверов не назначил
+		var lead uuid.UUID
+		for _, u := range created[1:] {
+			if !slices.Contains(pr.Reviewers, u.UserId) {
+				lead = u.UserId
+			}
+		}
+		_, err = teamService.UpdateMergePolicy(ctx, "team-merge-free-lead", domain.MergePolicy{
+			RequireLeadApproval: true,
+			LeadUserId:          &lead,
+		})
+		require.NoError(t, err)
+
+		// не назначенный и не лид по-прежнему не может оставить вердикт
+		_, err = prService.SubmitReview(ctx, prId, created[0].UserId, domain.ReviewStateApproved)
+		require.ErrorIs(t, err, service.ErrNotAssigned)
+
+		_, err = prService.SetMerged(ctx, prId, false)
+		require.ErrorIs(t, err, service.ErrNotApproved)
+
+		reviewed, err := prService.SubmitReview(ctx, prId, lead, domain.ReviewStateApproved)
+		require.NoError(t, err)
+		require.Contains(t, reviewed.Reviewers, lead)
+
+		merged, err := prService.SetMerged(ctx, prId, false)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusMERGED, merged.PullRequest.Status)
+
+		history, err := prService.GetHistory(ctx, prId)
+		require.NoError(t, err)
+		require.Equal(t, domain.ReviewerEventAssigned, history[2].Type)
+		require.Equal(t, &lead, history[2].UserId)
+	})
+}
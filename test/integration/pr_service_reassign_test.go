@@ -37,13 +37,13 @@ func Test_Reassign_StandardCase(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		prRes, err := service.CreateAndAssignPullRequest(ctx, prID, "feature pr", authorId)
+		prRes, err := service.CreateAndAssignPullRequest(ctx, prID, "feature pr", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, prRes.Reviewers, 2)
 
 		// Переназначаем первого ревьювера
 		oldUser := prRes.Reviewers[0]
-		res, err := service.Reassign(ctx, prID, oldUser)
+		res, err := service.Reassign(ctx, prID, oldUser, nil)
 		require.NoError(t, err)
 		require.Equal(t, prID, res.PullRequest.PullRequestId)
 		require.Len(t, res.Reviewers, 2)
@@ -86,12 +86,12 @@ func Test_Reassign_NoCandidates(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		prRes, err := prService.CreateAndAssignPullRequest(ctx, prID, "pr no candidates", authorId)
+		prRes, err := prService.CreateAndAssignPullRequest(ctx, prID, "pr no candidates", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, prRes.Reviewers, 2)
 
 		oldUser := prRes.Reviewers[0]
-		_, err = prService.Reassign(ctx, prID, oldUser)
+		_, err = prService.Reassign(ctx, prID, oldUser, nil)
 		require.ErrorIs(t, err, service.ErrNoCandidate)
 	})
 }
@@ -114,12 +114,12 @@ func Test_Reassign_OnlyOldUserInTeam(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		prRes, err := prService.CreateAndAssignPullRequest(ctx, prID, "single user team pr", authorId)
+		prRes, err := prService.CreateAndAssignPullRequest(ctx, prID, "single user team pr", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, prRes.Reviewers, 1)
 
 		oldUser := prRes.Reviewers[0]
-		_, err = prService.Reassign(ctx, prID, oldUser)
+		_, err = prService.Reassign(ctx, prID, oldUser, nil)
 		require.ErrorIs(t, err, service.ErrNoCandidate)
 	})
 }
@@ -147,7 +147,7 @@ func Test_Reassign_Randomness(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		prRes, err := service.CreateAndAssignPullRequest(ctx, prID, "pr random", authorId)
+		prRes, err := service.CreateAndAssignPullRequest(ctx, prID, "pr random", authorId, false)
 		require.NoError(t, err)
 		require.Len(t, prRes.Reviewers, 2)
 
@@ -164,7 +164,7 @@ func Test_Reassign_Randomness(t *testing.T) {
 				}
 			}
 
-			res, err := service.Reassign(ctx, prID, currentOldUser)
+			res, err := service.Reassign(ctx, prID, currentOldUser, nil)
 			require.NoError(t, err)
 			require.Len(t, res.Reviewers, 2)
 			replacedIds = append(replacedIds, res.Reviewers...)
@@ -205,11 +205,11 @@ func Test_Reassign_AfterMerged_Forbidden(t *testing.T) {
 		require.NotEqual(t, uuid.Nil, authorId)
 
 		prID := uuid.New()
-		prRes, err := svc.CreateAndAssignPullRequest(ctx, prID, "pr-to-merge", authorId)
+		prRes, err := svc.CreateAndAssignPullRequest(ctx, prID, "pr-to-merge", authorId, false)
 		require.NoError(t, err)
 
 		// merge it
-		merged, err := svc.SetMerged(ctx, prID)
+		merged, err := svc.SetMerged(ctx, prID, false)
 		require.NoError(t, err)
 		require.Equal(t, domain.PullRequestStatusMERGED, merged.Status)
 		require.NotNil(t, merged.MergedAt)
@@ -225,9 +225,9 @@ func Test_Reassign_AfterMerged_Forbidden(t *testing.T) {
 					break
 				}
 			}
-			_, err = svc.Reassign(ctx, prID, fallback)
+			_, err = svc.Reassign(ctx, prID, fallback, nil)
 		} else {
-			_, err = svc.Reassign(ctx, prID, prRes.Reviewers[0])
+			_, err = svc.Reassign(ctx, prID, prRes.Reviewers[0], nil)
 		}
 		require.ErrorIs(t, err, service.ErrPullRequestMerged)
 	})
@@ -238,7 +238,7 @@ func Test_Reassign_NonExistentPR(t *testing.T) {
 	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
 		svc := newPRServiceFromPool(pool, testDB.Getter)
 
-		_, err := svc.Reassign(ctx, uuid.New(), uuid.New())
+		_, err := svc.Reassign(ctx, uuid.New(), uuid.New(), nil)
 		require.ErrorIs(t, err, service.ErrPullRequestNotFound)
 	})
 }
@@ -268,7 +268,7 @@ func Test_Reassign_OldUserNotAssigned(t *testing.T) {
 		}
 
 		prID := uuid.New()
-		prRes, err := svc.CreateAndAssignPullRequest(ctx, prID, "pr-no-old", authorId)
+		prRes, err := svc.CreateAndAssignPullRequest(ctx, prID, "pr-no-old", authorId, false)
 		require.NoError(t, err)
 
 		// choose a user that is NOT assigned (candidate is not guaranteed to be unassigned, but we check)
@@ -292,7 +292,46 @@ func Test_Reassign_OldUserNotAssigned(t *testing.T) {
 		}
 
 		// now chosen should not be assigned
-		_, err = svc.Reassign(ctx, prID, chosen)
-		require.ErrorIs(t, err, service.ErrUserNotFound)
+		_, err = svc.Reassign(ctx, prID, chosen, nil)
+		require.ErrorIs(t, err, service.ErrNotAssigned)
+	})
+}
+
+func Test_Reassign_ToSpecificUser(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+			{UserId: uuid.New(), Username: "inactive", IsActive: false},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-reassign-target", users)
+
+		pr, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "target", created[0].UserId, false)
+		require.NoError(t, err)
+		prID := pr.PullRequest.PullRequestId
+		oldUser, kept := pr.Reviewers[0], pr.Reviewers[1]
+
+		var target uuid.UUID
+		for _, u := range created[1:4] {
+			if u.UserId != oldUser && u.UserId != kept {
+				target = u.UserId
+			}
+		}
+
+		inactive := created[4].UserId
+		_, err = svc.Reassign(ctx, prID, oldUser, &inactive)
+		require.ErrorIs(t, err, service.ErrInvalidReviewer)
+		_, err = svc.Reassign(ctx, prID, oldUser, &kept)
+		require.ErrorIs(t, err, service.ErrAlreadyAssigned)
+
+		res, err := svc.Reassign(ctx, prID, oldUser, &target)
+		require.NoError(t, err)
+		require.Equal(t, target, res.ReplacedBy)
+		require.ElementsMatch(t, []uuid.UUID{kept, target}, res.Reviewers)
 	})
 }
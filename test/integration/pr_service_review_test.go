@@ -0,0 +1,71 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SubmitReview_TracksStatePerReviewer(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-review-state", users)
+
+		pr, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "review me", created[0].UserId, false)
+		require.NoError(t, err)
+		require.Len(t, pr.Reviews, 2)
+		for _, r := range pr.Reviews {
+			require.Equal(t, domain.ReviewStatePending, r.State)
+			require.Nil(t, r.ReviewedAt)
+		}
+
+		approver, other := pr.Reviewers[0], pr.Reviewers[1]
+		res, err := svc.SubmitReview(ctx, pr.PullRequest.PullRequestId, approver, domain.ReviewStateApproved)
+		require.NoError(t, err)
+		for _, r := range res.Reviews {
+			if r.UserId == approver {
+				require.Equal(t, domain.ReviewStateApproved, r.State)
+				require.NotNil(t, r.ReviewedAt)
+			} else {
+				require.Equal(t, domain.ReviewStatePending, r.State)
+			}
+		}
+
+		// фильтр по PENDING: у одобрившего ревьювера ничего не осталось
+		pending := domain.ReviewStatePending
+		reviews, err := svc.GetAssignedReviewsByUserId(ctx, approver, &pending)
+		require.NoError(t, err)
+		require.Empty(t, reviews)
+
+		reviews, err = svc.GetAssignedReviewsByUserId(ctx, other, &pending)
+		require.NoError(t, err)
+		require.Len(t, reviews, 1)
+		require.Equal(t, domain.ReviewStatePending, *reviews[0].ReviewState)
+
+		_, err = svc.SubmitReview(ctx, pr.PullRequest.PullRequestId, created[0].UserId, domain.ReviewStateApproved)
+		require.ErrorIs(t, err, service.ErrNotAssigned)
+
+		_, err = svc.SubmitReview(ctx, pr.PullRequest.PullRequestId, other, domain.ReviewStatePending)
+		require.ErrorIs(t, err, service.ErrInvalidReviewState)
+
+		_, err = svc.SetMerged(ctx, pr.PullRequest.PullRequestId, false)
+		require.NoError(t, err)
+
+		_, err = svc.SubmitReview(ctx, pr.PullRequest.PullRequestId, other, domain.ReviewStateChangesRequested)
+		require.ErrorIs(t, err, service.ErrPullRequestMerged)
+	})
+}
@@ -33,11 +33,11 @@ func Test_SetMerged_MarksPRMergedAndSetsMergedAt(t *testing.T) {
 		require.NotEqual(t, uuid.Nil, authorId)
 
 		prID := uuid.New()
-		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-test", authorId)
+		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-test", authorId, false)
 		require.NoError(t, err)
 
 		// act
-		merged, err := prService.SetMerged(ctx, prID)
+		merged, err := prService.SetMerged(ctx, prID, false)
 
 		// assert
 		require.NoError(t, err)
@@ -71,7 +71,7 @@ func Test_SetMerged_PreventsReviewerChangesAfterMerge(t *testing.T) {
 		require.NotEqual(t, uuid.Nil, authorId)
 
 		prID := uuid.New()
-		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-prevent-changes", authorId)
+		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-prevent-changes", authorId, false)
 		require.NoError(t, err)
 
 		// read current reviewers (before merge)
@@ -79,9 +79,11 @@ func Test_SetMerged_PreventsReviewerChangesAfterMerge(t *testing.T) {
 		require.NoError(t, err)
 
 		// merge
-		merged, err := prService.SetMerged(ctx, prID)
+		merged, err := prService.SetMerged(ctx, prID, false)
 		require.NoError(t, err)
 		require.Equal(t, domain.PullRequestStatusMERGED, merged.Status)
+		// ревьюверы сохраняются в ответе merge
+		require.ElementsMatch(t, before, merged.Reviewers)
 
 		// attempt reassign — should error with ErrPullRequestMerged
 		if len(before) == 0 {
@@ -93,9 +95,9 @@ func Test_SetMerged_PreventsReviewerChangesAfterMerge(t *testing.T) {
 					break
 				}
 			}
-			_, err = prService.Reassign(ctx, prID, some)
+			_, err = prService.Reassign(ctx, prID, some, nil)
 		} else {
-			_, err = prService.Reassign(ctx, prID, before[0])
+			_, err = prService.Reassign(ctx, prID, before[0], nil)
 		}
 		require.ErrorIs(t, err, service.ErrPullRequestMerged)
 
@@ -125,17 +127,17 @@ func Test_SetMerged_Idempotent(t *testing.T) {
 		require.NotEqual(t, uuid.Nil, authorId)
 
 		prID := uuid.New()
-		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-idempotent", authorId)
+		_, err := prService.CreateAndAssignPullRequest(ctx, prID, "merge-idempotent", authorId, false)
 		require.NoError(t, err)
 
 		// first merge
-		m1, err := prService.SetMerged(ctx, prID)
+		m1, err := prService.SetMerged(ctx, prID, false)
 		require.NoError(t, err)
 		require.Equal(t, domain.PullRequestStatusMERGED, m1.Status)
 		require.NotNil(t, m1.MergedAt)
 
 		// second merge - should be idempotent (no error, same state)
-		m2, err := prService.SetMerged(ctx, prID)
+		m2, err := prService.SetMerged(ctx, prID, false)
 		require.NoError(t, err)
 		require.Equal(t, domain.PullRequestStatusMERGED, m2.Status)
 		require.NotNil(t, m2.MergedAt)
@@ -0,0 +1,98 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PullRequestStatus_DraftReadyCloseReopen(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-status", users)
+
+		// черновик создаётся без ревьюверов
+		draft, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "draft", created[0].UserId, true)
+		require.NoError(t, err)
+		prId := draft.PullRequest.PullRequestId
+		require.Equal(t, domain.PullRequestStatusDRAFT, draft.PullRequest.Status)
+		require.Empty(t, draft.Reviewers)
+
+		_, err = svc.SetMerged(ctx, prId, false)
+		require.ErrorIs(t, err, service.ErrInvalidStatusTransition)
+
+		_, err = svc.ReopenPullRequest(ctx, prId)
+		require.ErrorIs(t, err, service.ErrInvalidStatusTransition)
+
+		// ready назначает ревьюверов
+		ready, err := svc.MarkReady(ctx, prId)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusOPEN, ready.PullRequest.Status)
+		require.Len(t, ready.Reviewers, 2)
+
+		closed, err := svc.ClosePullRequest(ctx, prId)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusCLOSED, closed.PullRequest.Status)
+
+		// повторное закрытие идемпотентно
+		closed, err = svc.ClosePullRequest(ctx, prId)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusCLOSED, closed.PullRequest.Status)
+
+		_, err = svc.MarkReady(ctx, prId)
+		require.ErrorIs(t, err, service.ErrInvalidStatusTransition)
+		_, err = svc.Reassign(ctx, prId, ready.Reviewers[0], nil)
+		require.ErrorIs(t, err, service.ErrPullRequestNotOpen)
+		_, err = svc.SubmitReview(ctx, prId, ready.Reviewers[0], domain.ReviewStateApproved)
+		require.ErrorIs(t, err, service.ErrPullRequestNotOpen)
+
+		// ревьюверы сохраняются после переоткрытия
+		reopened, err := svc.ReopenPullRequest(ctx, prId)
+		require.NoError(t, err)
+		require.Equal(t, domain.PullRequestStatusOPEN, reopened.PullRequest.Status)
+		require.ElementsMatch(t, ready.Reviewers, reopened.Reviewers)
+
+		_, err = svc.SetMerged(ctx, prId, false)
+		require.NoError(t, err)
+
+		_, err = svc.ClosePullRequest(ctx, prId)
+		require.ErrorIs(t, err, service.ErrInvalidStatusTransition)
+	})
+}
+
+func Test_PullRequestStatus_ReopenClosedDraftAssignsReviewers(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		svc := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-status-draft", users)
+
+		draft, err := svc.CreateAndAssignPullRequest(ctx, uuid.New(), "draft", created[0].UserId, true)
+		require.NoError(t, err)
+
+		_, err = svc.ClosePullRequest(ctx, draft.PullRequest.PullRequestId)
+		require.NoError(t, err)
+
+		reopened, err := svc.ReopenPullRequest(ctx, draft.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{created[1].UserId}, reopened.Reviewers)
+	})
+}
@@ -0,0 +1,74 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/api/adapter/apperrors"
+	"avito-test-applicant/internal/api/adapter/handlers"
+	apigen "avito-test-applicant/internal/api/gen"
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ReviewAccess_OnlyOnOwnBehalf(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		server := handlers.NewServer(&service.Services{PullRequest: prService})
+
+		teamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-review-access", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "access", created[0].UserId, false)
+		require.NoError(t, err)
+		reviewer := pr.Reviewers[0]
+		other := pr.Reviewers[1]
+
+		review := apigen.PostPullRequestReviewRequestObject{Body: &apigen.PostPullRequestReviewJSONRequestBody{
+			PullRequestId: pr.PullRequest.PullRequestId.String(),
+			ReviewerId:    reviewer.String(),
+			State:         apigen.PostPullRequestReviewJSONBodyStateAPPROVED,
+		}}
+		getReview := apigen.GetUsersGetReviewRequestObject{Params: apigen.GetUsersGetReviewParams{
+			UserId: reviewer.String(),
+		}}
+
+		// ни админ, ни лид, ни другой ревьювер не действуют от чужого имени
+		foreign := []domain.Principal{
+			{Role: domain.RoleAdmin},
+			{Role: domain.RoleAdmin, UserId: &other},
+			{Role: domain.RoleTeamLead, UserId: &other, TeamId: &teamId},
+			{Role: domain.RoleUser, UserId: &other},
+		}
+		for _, p := range foreign {
+			asCaller := domain.WithPrincipal(ctx, p)
+			_, err := server.PostPullRequestReview(asCaller, review)
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+			_, err = server.GetUsersGetReview(asCaller, getReview)
+			require.ErrorIs(t, err, apperrors.ErrForbidden, "role %s", p.Role)
+		}
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		for _, r := range got.Reviews {
+			require.Equal(t, domain.ReviewStatePending, r.State)
+		}
+
+		self := domain.WithPrincipal(ctx, domain.Principal{Role: domain.RoleTeamLead, UserId: &reviewer, TeamId: &teamId})
+		resp, err := server.PostPullRequestReview(self, review)
+		require.NoError(t, err)
+		require.IsType(t, apigen.PostPullRequestReview200JSONResponse{}, resp)
+
+		reviews, err := server.GetUsersGetReview(self, getReview)
+		require.NoError(t, err)
+		require.Len(t, reviews.(apigen.GetUsersGetReview200JSONResponse).PullRequests, 1)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/repo/pgdb"
 	"avito-test-applicant/internal/repo/repoerrors"
 	"avito-test-applicant/pkg/postgres"
@@ -33,7 +34,7 @@ func TestReviewerRepo_AssignAndList(t *testing.T) {
 
 		// --- Подготовка пользователей и PR ---
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-review")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-review", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		user1, err := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
@@ -41,7 +42,7 @@ func TestReviewerRepo_AssignAndList(t *testing.T) {
 		_, err = userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
 		require.NoError(t, err)
 
-		pr, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 1", user1.UserId)
+		pr, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 1", user1.UserId, domain.PullRequestStatusOPEN)
 		require.NoError(t, err)
 
 		// --- Назначение одного ревьюера и проверка ListReviewers ---
@@ -63,10 +64,10 @@ func TestReviewerRepo_AssignAndRemove(t *testing.T) {
 		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
 
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
-		team, _ := teamRepo.CreateTeam(ctx, uuid.New(), "team-remove")
+		team, _ := teamRepo.CreateTeam(ctx, uuid.New(), "team-remove", domain.DefaultReviewersRequired)
 
 		user, _ := userRepo.CreateUser(ctx, uuid.New(), "charlie", true, team.TeamId)
-		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 2", user.UserId)
+		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 2", user.UserId, domain.PullRequestStatusOPEN)
 
 		// Назначаем и проверяем
 		err := reviewerRepo.AssignOne(ctx, pr.PullRequestId, user.UserId)
@@ -91,12 +92,12 @@ func TestReviewerRepo_MultipleReviewers(t *testing.T) {
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-multi")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-multi", domain.DefaultReviewersRequired)
 		user1, _ := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
 		user2, _ := userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
 		user3, _ := userRepo.CreateUser(ctx, uuid.New(), "charlie", true, team.TeamId)
 
-		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR multi", user1.UserId)
+		pr, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR multi", user1.UserId, domain.PullRequestStatusOPEN)
 
 		// Назначаем нескольких
 		for _, u := range []uuid.UUID{user1.UserId, user2.UserId, user3.UserId} {
@@ -118,13 +119,13 @@ func TestReviewerRepo_ListByUserId(t *testing.T) {
 		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
 		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
 
-		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-list")
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-list", domain.DefaultReviewersRequired)
 		user1, _ := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
 		user2, _ := userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
 
-		pr1, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR A", user1.UserId)
-		pr2, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR B", user1.UserId)
-		pr3, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR C", user2.UserId)
+		pr1, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR A", user1.UserId, domain.PullRequestStatusOPEN)
+		pr2, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR B", user1.UserId, domain.PullRequestStatusOPEN)
+		pr3, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR C", user2.UserId, domain.PullRequestStatusOPEN)
 
 		// Назначаем
 		reviewerRepo.AssignOne(ctx, pr1.PullRequestId, user1.UserId)
@@ -143,6 +144,39 @@ func TestReviewerRepo_ListByUserId(t *testing.T) {
 	})
 }
 
+func TestReviewerRepo_CountOpenByUserIds(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		userRepo := newUserRepoFromPool(pool, testDB.Getter)
+		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
+		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
+
+		team, _ := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-load", domain.DefaultReviewersRequired)
+		author, _ := userRepo.CreateUser(ctx, uuid.New(), "author", true, team.TeamId)
+		user1, _ := userRepo.CreateUser(ctx, uuid.New(), "alice", true, team.TeamId)
+		user2, _ := userRepo.CreateUser(ctx, uuid.New(), "bob", true, team.TeamId)
+		idle, _ := userRepo.CreateUser(ctx, uuid.New(), "idle", true, team.TeamId)
+
+		pr1, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR A", author.UserId, domain.PullRequestStatusOPEN)
+		pr2, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR B", author.UserId, domain.PullRequestStatusOPEN)
+		merged, _ := prRepo.CreatePullRequest(ctx, uuid.New(), "PR C", author.UserId, domain.PullRequestStatusOPEN)
+
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr1.PullRequestId, user1.UserId))
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr2.PullRequestId, user1.UserId))
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr1.PullRequestId, user2.UserId))
+		require.NoError(t, reviewerRepo.AssignOne(ctx, merged.PullRequestId, user2.UserId))
+		_, err := prRepo.SetMerged(ctx, merged.PullRequestId)
+		require.NoError(t, err)
+
+		// merged PRs не учитываются в нагрузке
+		counts, err := reviewerRepo.CountOpenByUserIds(ctx, []uuid.UUID{user1.UserId, user2.UserId, idle.UserId})
+		require.NoError(t, err)
+		require.Equal(t, 2, counts[user1.UserId])
+		require.Equal(t, 1, counts[user2.UserId])
+		require.Equal(t, 0, counts[idle.UserId])
+	})
+}
+
 func TestReviewerRepo_Errors(t *testing.T) {
 
 	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
@@ -157,3 +191,35 @@ func TestReviewerRepo_Errors(t *testing.T) {
 
 	})
 }
+
+func TestReviewerRepo_RejectsChangesOnMergedPR(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		userRepo := newUserRepoFromPool(pool, testDB.Getter)
+		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
+		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
+
+		team, err := newTeamRepoFromPool(pool, testDB.Getter).CreateTeam(ctx, uuid.New(), "team-frozen", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+		author, _ := userRepo.CreateUser(ctx, uuid.New(), "author", true, team.TeamId)
+		reviewer, _ := userRepo.CreateUser(ctx, uuid.New(), "reviewer", true, team.TeamId)
+		late, _ := userRepo.CreateUser(ctx, uuid.New(), "late", true, team.TeamId)
+
+		pr, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR frozen", author.UserId, domain.PullRequestStatusOPEN)
+		require.NoError(t, err)
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr.PullRequestId, reviewer.UserId))
+
+		_, err = prRepo.SetMerged(ctx, pr.PullRequestId)
+		require.NoError(t, err)
+
+		// после merge набор ревьюверов неизменен
+		err = reviewerRepo.AssignOne(ctx, pr.PullRequestId, late.UserId)
+		require.ErrorIs(t, err, repoerrors.ErrMerged)
+		err = reviewerRepo.RemoveOne(ctx, pr.PullRequestId, reviewer.UserId)
+		require.ErrorIs(t, err, repoerrors.ErrMerged)
+
+		reviewers, err := reviewerRepo.ListReviewers(ctx, pr.PullRequestId)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{reviewer.UserId}, reviewers)
+	})
+}
@@ -0,0 +1,86 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/repo/pgdb"
+	"avito-test-applicant/pkg/postgres"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/Masterminds/squirrel"
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func newStatsRepoFromPool(pool *pgxpool.Pool, getter *trmpgx.CtxGetter) *pgdb.StatsRepo {
+	pg := &postgres.Postgres{
+		Builder: squirrel.StatementBuilder.PlaceholderFormat(squirrel.Dollar),
+		Pool:    pool,
+	}
+	return pgdb.NewStatsRepo(pg, getter)
+}
+
+func TestStatsRepo_GetStats(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
+		userRepo := newUserRepoFromPool(pool, testDB.Getter)
+		prRepo := newPullRequestRepoFromPool(pool, testDB.Getter)
+		reviewerRepo := newReviewerRepoFromPool(pool, testDB.Getter)
+		statsRepo := newStatsRepoFromPool(pool, testDB.Getter)
+
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-stats", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+		other, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-stats-other", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+
+		author, err := userRepo.CreateUser(ctx, uuid.New(), "author", true, team.TeamId)
+		require.NoError(t, err)
+		reviewer, err := userRepo.CreateUser(ctx, uuid.New(), "reviewer", true, team.TeamId)
+		require.NoError(t, err)
+		outsider, err := userRepo.CreateUser(ctx, uuid.New(), "outsider", true, other.TeamId)
+		require.NoError(t, err)
+
+		// PR 1: два ревьювера, смержен; PR 2: один ревьювер, открыт; PR 3: без ревьюверов
+		pr1, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 1", author.UserId, domain.PullRequestStatusOPEN)
+		require.NoError(t, err)
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr1.PullRequestId, reviewer.UserId))
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr1.PullRequestId, outsider.UserId))
+		_, err = prRepo.SetMerged(ctx, pr1.PullRequestId)
+		require.NoError(t, err)
+
+		pr2, err := prRepo.CreatePullRequest(ctx, uuid.New(), "PR 2", author.UserId, domain.PullRequestStatusOPEN)
+		require.NoError(t, err)
+		require.NoError(t, reviewerRepo.AssignOne(ctx, pr2.PullRequestId, reviewer.UserId))
+
+		_, err = prRepo.CreatePullRequest(ctx, uuid.New(), "PR 3", outsider.UserId, domain.PullRequestStatusOPEN)
+		require.NoError(t, err)
+
+		stats, err := statsRepo.GetStats(ctx, nil)
+		require.NoError(t, err)
+		require.Equal(t, 3, stats.TotalPullRequests)
+		require.Equal(t, 1, stats.PullRequestsWithoutReviewers)
+		require.InDelta(t, 1.0, stats.AvgReviewersPerPullRequest, 1e-9)
+		require.Len(t, stats.Reviewers, 3)
+		require.Equal(t, reviewer.UserId, stats.Reviewers[0].UserId)
+		require.Equal(t, 2, stats.Reviewers[0].TotalAssignments)
+		require.Equal(t, 1, stats.Reviewers[0].OpenReviews)
+		require.Equal(t, 1, stats.Reviewers[0].MergedReviews)
+		require.Len(t, stats.Authors, 2)
+		require.Equal(t, author.UserId, stats.Authors[0].UserId)
+		require.Equal(t, 2, stats.Authors[0].PullRequests)
+
+		// по команде: только PR авторов команды и её участники
+		teamStats, err := statsRepo.GetStats(ctx, &team.TeamId)
+		require.NoError(t, err)
+		require.Equal(t, 2, teamStats.TotalPullRequests)
+		require.Equal(t, 0, teamStats.PullRequestsWithoutReviewers)
+		require.InDelta(t, 1.5, teamStats.AvgReviewersPerPullRequest, 1e-9)
+		require.Len(t, teamStats.Reviewers, 2)
+		require.Len(t, teamStats.Authors, 1)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"avito-test-applicant/internal/domain"
 	"avito-test-applicant/internal/repo/pgdb"
 	"avito-test-applicant/internal/repo/repoerrors"
 	"avito-test-applicant/pkg/postgres"
@@ -34,13 +35,13 @@ func TestTeamRepo_CreateAndGet(t *testing.T) {
 		teamId, _ := uuid.Parse("11111111-1111-1111-1111-111111111111")
 
 		// Создание команды
-		team, err := repo.CreateTeam(ctx, teamId, teamName)
+		team, err := repo.CreateTeam(ctx, teamId, teamName, domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 		require.NotEqual(t, uuid.Nil, team.TeamId)
 		require.Equal(t, teamName, team.TeamName)
 
 		// Повторная попытка создания должна выдавать ErrAlreadyExists
-		_, err = repo.CreateTeam(ctx, teamId, teamName)
+		_, err = repo.CreateTeam(ctx, teamId, teamName, domain.DefaultReviewersRequired)
 		require.ErrorIs(t, err, repoerrors.ErrAlreadyExists)
 
 		// Получение по имени
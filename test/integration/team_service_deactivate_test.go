@@ -0,0 +1,152 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_DeactivateUsers_RedistributesOpenReviews(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+			{UserId: uuid.New(), Username: "u4", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-bulk", users)
+		authorId := created[0].UserId
+
+		prs := make([]domain.PullRequestWithReviewers, 0, 5)
+		for i := 0; i < 5; i++ {
+			pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "bulk", authorId, false)
+			require.NoError(t, err)
+			prs = append(prs, pr)
+		}
+
+		leaving := []uuid.UUID{created[1].UserId, created[2].UserId}
+		res, err := teamService.DeactivateUsers(ctx, "team-bulk", leaving, false)
+		require.NoError(t, err)
+		require.ElementsMatch(t, leaving, res.Deactivated)
+
+		// у каждого ревью есть замена: u3 и u4 свободны и не автор
+		for _, h := range res.Reviews {
+			require.NotNil(t, h.ReplacedBy)
+			require.NotContains(t, leaving, *h.ReplacedBy)
+			require.NotEqual(t, authorId, *h.ReplacedBy)
+		}
+
+		for _, uid := range leaving {
+			reviews, err := prService.GetAssignedReviewsByUserId(ctx, uid, nil)
+			require.NoError(t, err)
+			require.Empty(t, reviews)
+		}
+
+		// число ревьюверов в каждом PR не изменилось
+		total := 0
+		for _, uid := range []uuid.UUID{created[3].UserId, created[4].UserId} {
+			reviews, err := prService.GetAssignedReviewsByUserId(ctx, uid, nil)
+			require.NoError(t, err)
+			total += len(reviews)
+		}
+		require.Equal(t, 2*len(prs), total)
+	})
+}
+
+func Test_DeactivateUsers_AllUnassignsReviewsWithoutCandidates(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-bulk-all", users)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "bulk all", created[0].UserId, false)
+		require.NoError(t, err)
+
+		res, err := teamService.DeactivateUsers(ctx, "team-bulk-all", nil, true)
+		require.NoError(t, err)
+		require.Len(t, res.Deactivated, 3)
+		require.Len(t, res.Reviews, 2)
+		for _, h := range res.Reviews {
+			require.Equal(t, pr.PullRequest.PullRequestId, h.PullRequestId)
+			require.Nil(t, h.ReplacedBy)
+		}
+
+		// ревью без кандидата снимается, как при исключении из команды
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.Empty(t, got.Reviewers)
+
+		history, err := prService.GetHistory(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		unassigned := 0
+		for _, e := range history {
+			if e.Type == domain.ReviewerEventUnassigned {
+				unassigned++
+			}
+		}
+		require.Equal(t, 2, unassigned)
+
+		team, err := teamService.GetTeamByName(ctx, "team-bulk-all")
+		require.NoError(t, err)
+		for _, u := range team.Users {
+			require.False(t, u.IsActive)
+		}
+
+		_, err = teamService.DeactivateUsers(ctx, "team-bulk-all", []uuid.UUID{uuid.New()}, false)
+		require.ErrorIs(t, err, service.ErrUserNotInTeam)
+	})
+}
+
+func Test_DeactivateUsers_HandsOverToFallbackTeam(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		_, home := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-home", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		_, partner := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-partner", []domain.User{
+			{UserId: uuid.New(), Username: "p1", IsActive: true},
+		})
+		_, err := teamService.SetFallbackTeams(ctx, "team-home", []string{"team-partner"})
+		require.NoError(t, err)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "fallback", home[0].UserId, false)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{home[1].UserId, home[2].UserId}, pr.Reviewers)
+
+		// в своей команде свободных нет, ревью уходит в резервную
+		res, err := teamService.DeactivateUsers(ctx, "team-home", []uuid.UUID{home[1].UserId}, false)
+		require.NoError(t, err)
+		require.Len(t, res.Reviews, 1)
+		require.NotNil(t, res.Reviews[0].ReplacedBy)
+		require.Equal(t, partner[0].UserId, *res.Reviews[0].ReplacedBy)
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []uuid.UUID{home[2].UserId, partner[0].UserId}, got.Reviewers)
+		require.Equal(t, []uuid.UUID{partner[0].UserId}, got.FallbackReviewers)
+	})
+}
@@ -0,0 +1,305 @@
+package integration_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoveUser_HandsOverReviewsWithinOldTeam(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-old", users)
+		setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-new", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "n1", IsActive: true},
+		})
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "move", created[0].UserId, false)
+		require.NoError(t, err)
+		require.Len(t, pr.Reviewers, 2)
+
+		var moving, free uuid.UUID
+		for _, u := range created[1:] {
+			if slices.Contains(pr.Reviewers, u.UserId) {
+				if moving == uuid.Nil {
+					moving = u.UserId
+				}
+			} else {
+				free = u.UserId
+			}
+		}
+
+		// имя author уже занято в новой команде
+		_, err = teamService.MoveUser(ctx, created[0].UserId, "team-new")
+		require.ErrorIs(t, err, service.ErrUsernameTaken)
+
+		res, err := teamService.MoveUser(ctx, moving, "team-new")
+		require.NoError(t, err)
+		require.Equal(t, "team-new", res.User.TeamName)
+		require.Len(t, res.Reviews, 1)
+		require.NotNil(t, res.Reviews[0].ReplacedBy)
+		require.Equal(t, free, *res.Reviews[0].ReplacedBy)
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.NotContains(t, got.Reviewers, moving)
+		require.Contains(t, got.Reviewers, free)
+
+		newTeam, err := teamService.GetTeamByName(ctx, "team-new")
+		require.NoError(t, err)
+		require.Len(t, newTeam.Users, 3)
+
+		// повторный перевод в ту же команду ничего не меняет
+		res, err = teamService.MoveUser(ctx, moving, "team-new")
+		require.NoError(t, err)
+		require.Empty(t, res.Reviews)
+
+		_, err = teamService.MoveUser(ctx, uuid.New(), "team-new")
+		require.ErrorIs(t, err, service.ErrUserNotFound)
+		_, err = teamService.MoveUser(ctx, moving, "team-missing")
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
+
+func Test_MoveUser_UnassignsReviewsWithoutCandidates(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-move-old", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-move-new", nil)
+		moving := created[1].UserId
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "move", created[0].UserId, false)
+		require.NoError(t, err)
+		require.Contains(t, pr.Reviewers, moving)
+
+		// u2 уже ревьювер, автор не может ревьюить свой PR
+		res, err := teamService.MoveUser(ctx, moving, "team-move-new")
+		require.NoError(t, err)
+		require.Len(t, res.Reviews, 1)
+		require.Nil(t, res.Reviews[0].ReplacedBy)
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.Equal(t, []uuid.UUID{created[2].UserId}, got.Reviewers)
+
+		reviews, err := prService.GetAssignedReviewsByUserId(ctx, moving, nil)
+		require.NoError(t, err)
+		require.Empty(t, reviews)
+
+		history, err := prService.GetHistory(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		last := history[len(history)-1]
+		require.Equal(t, domain.ReviewerEventUnassigned, last.Type)
+		require.Equal(t, &moving, last.UserId)
+	})
+}
+
+func Test_RemoveMember_UnassignsReviewsWithoutCandidates(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-remove", users)
+		leaving := created[1].UserId
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "remove", created[0].UserId, false)
+		require.NoError(t, err)
+		require.Contains(t, pr.Reviewers, leaving)
+
+		res, err := teamService.RemoveMember(ctx, "team-remove", leaving)
+		require.NoError(t, err)
+		require.False(t, res.User.IsActive)
+		require.Empty(t, res.User.TeamName)
+		// u2 уже ревьювер, автор не может ревьюить свой PR
+		require.Len(t, res.Reviews, 1)
+		require.Nil(t, res.Reviews[0].ReplacedBy)
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.NotContains(t, got.Reviewers, leaving)
+
+		team, err := teamService.GetTeamByName(ctx, "team-remove")
+		require.NoError(t, err)
+		require.Len(t, team.Users, 2)
+
+		// исключённый пользователь не может открывать PR
+		_, err = prService.CreateAndAssignPullRequest(ctx, uuid.New(), "orphan", leaving, false)
+		require.ErrorIs(t, err, service.ErrAuthorNotFound)
+
+		_, err = teamService.RemoveMember(ctx, "team-remove", leaving)
+		require.ErrorIs(t, err, service.ErrUserNotInTeam)
+
+		// исключённого можно перевести в другую команду
+		setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-other", nil)
+		moved, err := teamService.MoveUser(ctx, leaving, "team-other")
+		require.NoError(t, err)
+		require.Equal(t, "team-other", moved.User.TeamName)
+		require.Empty(t, moved.Reviews)
+	})
+}
+
+func Test_AddMembersAndRenameTeam(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-members", []domain.User{
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+		setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-taken", nil)
+
+		team, err := teamService.AddMembers(ctx, "team-members", []domain.UserInput{
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		require.NoError(t, err)
+		require.Len(t, team.Users, 2)
+
+		_, err = teamService.AddMembers(ctx, "team-members", []domain.UserInput{
+			{UserId: created[0].UserId, Username: "other", IsActive: true},
+		})
+		require.ErrorIs(t, err, service.ErrUserAlreadyExists)
+
+		_, err = teamService.AddMembers(ctx, "team-members", []domain.UserInput{
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+		require.ErrorIs(t, err, service.ErrUsernameTaken)
+
+		_, err = teamService.AddMembers(ctx, "team-missing", nil)
+		require.ErrorIs(t, err, service.ErrNotFound)
+
+		_, err = teamService.RenameTeam(ctx, "team-members", "team-taken")
+		require.ErrorIs(t, err, service.ErrTeamAlreadyExists)
+
+		renamed, err := teamService.RenameTeam(ctx, "team-members", "team-renamed")
+		require.NoError(t, err)
+		require.Equal(t, "team-renamed", renamed.Team.TeamName)
+		require.Len(t, renamed.Users, 2)
+
+		_, err = teamService.GetTeamByName(ctx, "team-members")
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
+
+func Test_CreateTeamWithUsers_ExistingUsersNeedExplicitMove(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		repos := newReposFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-source", users)
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "hijack", created[0].UserId, false)
+		require.NoError(t, err)
+		moving := pr.Reviewers[0]
+
+		members := []domain.UserInput{
+			{UserId: moving, Username: "renamed", IsActive: true},
+			{UserId: uuid.New(), Username: "fresh", IsActive: true},
+		}
+
+		// по умолчанию пользователь не уводится из команды молча
+		_, err = teamService.CreateTeamWithUsers(ctx, "team-target", 2, members, false)
+		require.ErrorIs(t, err, service.ErrUserInOtherTeam)
+		var conflict *service.UsersInOtherTeamError
+		require.ErrorAs(t, err, &conflict)
+		require.Len(t, conflict.Users, 1)
+		require.Equal(t, moving, conflict.Users[0].UserId)
+		require.Equal(t, "team-source", conflict.Users[0].TeamName)
+
+		_, err = teamService.GetTeamByName(ctx, "team-target")
+		require.ErrorIs(t, err, service.ErrNotFound)
+
+		res, err := teamService.CreateTeamWithUsers(ctx, "team-target", 2, members, true)
+		require.NoError(t, err)
+		require.Len(t, res.Users, 2)
+		require.Len(t, res.Moved, 1)
+		require.Equal(t, moving, res.Moved[0].User.UserId)
+		require.Len(t, res.Moved[0].Reviews, 1)
+		require.NotNil(t, res.Moved[0].Reviews[0].ReplacedBy)
+
+		got, err := prService.GetPullRequestById(ctx, pr.PullRequest.PullRequestId)
+		require.NoError(t, err)
+		require.NotContains(t, got.Reviewers, moving)
+		require.Len(t, got.Reviewers, 2)
+
+		events, err := repos.MembershipEvent.ListByUserId(ctx, moving)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+		require.NotNil(t, events[0].FromTeamId)
+		require.Equal(t, res.Team.TeamId, *events[0].ToTeamId)
+	})
+}
+
+func Test_CreateTeamWithUsers_TeamlessUserJoinsWithoutConflict(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+
+		oldTeamId, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-left", []domain.User{
+			{UserId: uuid.New(), Username: "stays", IsActive: true},
+			{UserId: uuid.New(), Username: "leaves", IsActive: true},
+		})
+		leaving := created[1].UserId
+
+		_, err := teamService.RemoveMember(ctx, "team-left", leaving)
+		require.NoError(t, err)
+
+		// пользователь без команды не считается занятым другой командой
+		members := []domain.UserInput{{UserId: leaving, Username: "leaves", IsActive: true}}
+		res, err := teamService.CreateTeamWithUsers(ctx, "team-joined", 1, members, false)
+		require.NoError(t, err)
+		require.Empty(t, res.Moved)
+		require.Len(t, res.Users, 1)
+		require.Equal(t, res.Team.TeamId, res.Users[0].TeamId)
+
+		events, err := userService.GetTeamHistory(ctx, leaving)
+		require.NoError(t, err)
+		require.Len(t, events, 2)
+		require.Equal(t, oldTeamId, *events[0].FromTeamId)
+		require.Nil(t, events[0].ToTeamId)
+		require.Nil(t, events[1].FromTeamId)
+		require.Equal(t, res.Team.TeamId, *events[1].ToTeamId)
+
+		_, err = userService.GetTeamHistory(ctx, uuid.New())
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
@@ -0,0 +1,133 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/pkg/postgres"
+	"avito-test-applicant/test/helpers"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func newTeamServiceFromPool(pool *pgxpool.Pool, getter *trmpgx.CtxGetter) *service.TeamService {
+	repos := newReposFromPool(pool, getter)
+
+	trManager := postgres.NewTransactionManager(pool)
+
+	return service.NewTeamService(
+		repos,
+		trManager,
+		service.NewReviewerSelectors(repos),
+		domain.SelectionStrategyLeastLoaded,
+		service.NewTimeRandSource(),
+	)
+}
+
+func Test_TeamSettings_DefaultsAndUpdate(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+
+		_, err := teamService.CreateTeamWithUsers(ctx, "team-settings", domain.DefaultReviewersRequired, []domain.UserInput{
+			{UserId: uuid.New(), Username: "alice", IsActive: true},
+		}, false)
+		require.NoError(t, err)
+
+		// без явных настроек возвращается стратегия по умолчанию
+		settings, err := teamService.GetTeamSettings(ctx, "team-settings")
+		require.NoError(t, err)
+		require.Equal(t, domain.SelectionStrategyLeastLoaded, settings.SelectionStrategy)
+		require.Equal(t, domain.DefaultReviewersRequired, settings.ReviewersRequired)
+
+		_, err = teamService.UpdateTeamSettings(ctx, "team-settings", domain.SelectionStrategyRoundRobin, nil)
+		require.NoError(t, err)
+
+		settings, err = teamService.GetTeamSettings(ctx, "team-settings")
+		require.NoError(t, err)
+		require.Equal(t, domain.SelectionStrategyRoundRobin, settings.SelectionStrategy)
+		require.Equal(t, domain.DefaultReviewersRequired, settings.ReviewersRequired)
+
+		three := 3
+		settings, err = teamService.UpdateTeamSettings(ctx, "team-settings", domain.SelectionStrategyRoundRobin, &three)
+		require.NoError(t, err)
+		require.Equal(t, 3, settings.ReviewersRequired)
+
+		team, err := teamService.GetTeamByName(ctx, "team-settings")
+		require.NoError(t, err)
+		require.Equal(t, 3, team.Team.ReviewersRequired)
+
+		zero := 0
+		_, err = teamService.UpdateTeamSettings(ctx, "team-settings", domain.SelectionStrategyRoundRobin, &zero)
+		require.ErrorIs(t, err, service.ErrInvalidReviewersRequired)
+
+		_, err = teamService.UpdateTeamSettings(ctx, "team-settings", "fastest", nil)
+		require.ErrorIs(t, err, service.ErrUnknownSelectionStrategy)
+
+		_, err = teamService.UpdateTeamSettings(ctx, "missing-team", domain.SelectionStrategyRandom, nil)
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}
+
+func Test_CreateAndAssign_RoundRobinRotatesThroughTeam(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "a", IsActive: true},
+			{UserId: uuid.New(), Username: "b", IsActive: true},
+			{UserId: uuid.New(), Username: "c", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-rr", users)
+		var authorId uuid.UUID
+		for _, u := range created {
+			if u.Username == "author" {
+				authorId = u.UserId
+			}
+		}
+
+		_, err := teamService.UpdateTeamSettings(ctx, "team-rr", domain.SelectionStrategyRoundRobin, nil)
+		require.NoError(t, err)
+
+		first, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "rr 1", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, first.Reviewers, 2)
+
+		second, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "rr 2", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, second.Reviewers, 2)
+
+		// тот, кого пропустили в первый раз, должен попасть во второй PR
+		seen := make(map[uuid.UUID]struct{})
+		for _, id := range append(first.Reviewers, second.Reviewers...) {
+			seen[id] = struct{}{}
+		}
+		require.Len(t, seen, 3)
+	})
+}
+
+func Test_CreateTeam_InvalidReviewersRequired(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamService := newTeamServiceFromPool(pool, testDB.Getter)
+
+		_, err := teamService.CreateTeamWithUsers(ctx, "team-zero", 0, nil, false)
+		require.ErrorIs(t, err, service.ErrInvalidReviewersRequired)
+
+		team, err := teamService.CreateTeamWithUsers(ctx, "team-three", 3, nil, false)
+		require.NoError(t, err)
+		require.Equal(t, 3, team.Team.ReviewersRequired)
+
+		got, err := teamService.GetTeamByName(ctx, "team-three")
+		require.NoError(t, err)
+		require.Equal(t, 3, got.Team.ReviewersRequired)
+	})
+}
@@ -33,7 +33,7 @@ func TestUserRepo_CreateAndGet(t *testing.T) {
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-create-get")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-create-get", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		userId := uuid.New()
@@ -56,7 +56,7 @@ func TestUserRepo_UniqueUsername(t *testing.T) {
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-unique")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-unique", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		username := "bob"
@@ -76,7 +76,7 @@ func TestUserRepo_SetIsActive(t *testing.T) {
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-activate")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-activate", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		userId := uuid.New()
@@ -100,7 +100,7 @@ func TestUserRepo_UpdateUser(t *testing.T) {
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-update")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-update", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		userId := uuid.New()
@@ -123,7 +123,7 @@ func TestUserRepo_GetUsersByTeam(t *testing.T) {
 		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
 		userRepo := newUserRepoFromPool(pool, testDB.Getter)
 
-		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-all-users")
+		team, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-all-users", domain.DefaultReviewersRequired)
 		require.NoError(t, err)
 
 		userRepo.CreateUser(ctx, uuid.New(), "eve", true, team.TeamId)
@@ -172,3 +172,36 @@ func TestUserRepo_Errors(t *testing.T) {
 		require.ErrorIs(t, err, repoerrors.ErrNotFound)
 	})
 }
+
+// --- Активные участники нескольких команд одним запросом ---
+func TestUserRepo_GetActiveUsersByTeamIds(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		teamRepo := newTeamRepoFromPool(pool, testDB.Getter)
+		userRepo := newUserRepoFromPool(pool, testDB.Getter)
+
+		home, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-home", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+		fallback, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-fallback", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+		other, err := teamRepo.CreateTeam(ctx, uuid.New(), "team-other", domain.DefaultReviewersRequired)
+		require.NoError(t, err)
+
+		a, err := userRepo.CreateUser(ctx, uuid.New(), "a", true, home.TeamId)
+		require.NoError(t, err)
+		_, err = userRepo.CreateUser(ctx, uuid.New(), "inactive", false, home.TeamId)
+		require.NoError(t, err)
+		b, err := userRepo.CreateUser(ctx, uuid.New(), "b", true, fallback.TeamId)
+		require.NoError(t, err)
+		_, err = userRepo.CreateUser(ctx, uuid.New(), "c", true, other.TeamId)
+		require.NoError(t, err)
+
+		users, err := userRepo.GetActiveUsersByTeamIds(ctx, []uuid.UUID{home.TeamId, fallback.TeamId})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []domain.User{a, b}, users)
+
+		byIds, err := userRepo.GetUsersByIds(ctx, []uuid.UUID{a.UserId, b.UserId, uuid.New()})
+		require.NoError(t, err)
+		require.ElementsMatch(t, []domain.User{a, b}, byIds)
+	})
+}
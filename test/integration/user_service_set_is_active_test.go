@@ -0,0 +1,93 @@
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/pkg/postgres"
+	"avito-test-applicant/test/helpers"
+
+	trmpgx "github.com/avito-tech/go-transaction-manager/drivers/pgxv5/v2"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+func newUserServiceFromPool(pool *pgxpool.Pool, getter *trmpgx.CtxGetter) *service.UserService {
+	repos := newReposFromPool(pool, getter)
+
+	trManager := postgres.NewTransactionManager(pool)
+
+	return service.NewUserService(repos, trManager, newPRServiceFromPool(pool, getter))
+}
+
+func Test_SetIsActive_DeactivationReassignsOpenReviews(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+			{UserId: uuid.New(), Username: "u3", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-deactivate", users)
+		authorId := created[0].UserId
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "deactivate", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, pr.Reviewers, 2)
+
+		leaving := pr.Reviewers[0]
+		change, err := userService.SetIsActive(ctx, leaving, false)
+		require.NoError(t, err)
+		require.False(t, change.User.IsActive)
+		require.Empty(t, change.NotReassigned)
+		require.Len(t, change.Reassigned, 1)
+		require.Equal(t, pr.PullRequest.PullRequestId, change.Reassigned[0].PullRequestId)
+
+		// деактивированный пользователь больше не ревьювер этого PR
+		reviews, err := prService.GetAssignedReviewsByUserId(ctx, leaving, nil)
+		require.NoError(t, err)
+		require.Empty(t, reviews)
+
+		reviews, err = prService.GetAssignedReviewsByUserId(ctx, change.Reassigned[0].ReplacedBy, nil)
+		require.NoError(t, err)
+		require.Len(t, reviews, 1)
+	})
+}
+
+func Test_SetIsActive_DeactivationReportsPullRequestsWithoutCandidates(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		userService := newUserServiceFromPool(pool, testDB.Getter)
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		// author + 2 reviewers: замены нет
+		users := []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		}
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-no-candidate", users)
+		authorId := created[0].UserId
+
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "no candidate", authorId, false)
+		require.NoError(t, err)
+		require.Len(t, pr.Reviewers, 2)
+
+		change, err := userService.SetIsActive(ctx, pr.Reviewers[0], false)
+		require.NoError(t, err)
+		require.Empty(t, change.Reassigned)
+		require.Equal(t, []uuid.UUID{pr.PullRequest.PullRequestId}, change.NotReassigned)
+
+		// ревьювер остаётся назначенным
+		reviews, err := prService.GetAssignedReviewsByUserId(ctx, pr.Reviewers[0], nil)
+		require.NoError(t, err)
+		require.Len(t, reviews, 1)
+	})
+}
@@ -0,0 +1,312 @@
+package integration_test
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"avito-test-applicant/internal/domain"
+	"avito-test-applicant/internal/service"
+	"avito-test-applicant/pkg/postgres"
+	"avito-test-applicant/test/helpers"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver records requests and answers with the next queued status, 200 when none is left
+type webhookReceiver struct {
+	mu       sync.Mutex
+	requests []receivedWebhook
+	statuses []int
+}
+
+type receivedWebhook struct {
+	header http.Header
+	body   []byte
+}
+
+func (r *webhookReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	body, _ := io.ReadAll(req.Body)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.requests = append(r.requests, receivedWebhook{header: req.Header.Clone(), body: body})
+	status := http.StatusOK
+	if len(r.statuses) > 0 {
+		status, r.statuses = r.statuses[0], r.statuses[1:]
+	}
+	w.WriteHeader(status)
+}
+
+func (r *webhookReceiver) received() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.requests...)
+}
+
+func newWebhookDispatcher(pool *pgxpool.Pool, cfg service.WebhookDispatcherConfig) *service.WebhookDispatcher {
+	return service.NewWebhookDispatcher(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool), cfg)
+}
+
+// dispatchAll sends deliveries until none is due
+func dispatchAll(ctx context.Context, t *testing.T, dispatcher *service.WebhookDispatcher) {
+	for {
+		n, err := dispatcher.DispatchPending(ctx)
+		require.NoError(t, err)
+		if n == 0 {
+			return
+		}
+	}
+}
+
+func Test_Webhooks_DeliverSignedEvents(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		receiver := &webhookReceiver{}
+		server := httptest.NewServer(receiver)
+		defer server.Close()
+
+		webhooks := service.NewWebhookService(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool))
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		dispatcher := newWebhookDispatcher(pool, service.WebhookDispatcherConfig{})
+
+		secret, all, err := webhooks.CreateWebhook(ctx, server.URL+"/all", nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, secret)
+		require.True(t, all.IsActive)
+		_, merged, err := webhooks.CreateWebhook(ctx, server.URL+"/merged", []domain.EventType{
+			domain.EventPullRequestMerged,
+		})
+		require.NoError(t, err)
+
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-hooks", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+			{UserId: uuid.New(), Username: "u2", IsActive: true},
+		})
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "hooks", created[0].UserId, false)
+		require.NoError(t, err)
+		_, err = prService.SetMerged(ctx, pr.PullRequest.PullRequestId, true)
+		require.NoError(t, err)
+
+		// до диспетчера ничего не отправляется
+		require.Empty(t, receiver.received())
+
+		dispatchAll(ctx, t, dispatcher)
+
+		got := receiver.received()
+		// created, два назначения и merged для первого webhook-а, merged для второго
+		require.Len(t, got, 5)
+
+		types := make(map[domain.EventType]int)
+		for _, r := range got {
+			timestamp := r.header.Get(service.HeaderWebhookTimestamp)
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(timestamp + "."))
+			mac.Write(r.body)
+			if r.header.Get(service.HeaderWebhookSignature) == "sha256="+hex.EncodeToString(mac.Sum(nil)) {
+				var event domain.Event
+				require.NoError(t, json.Unmarshal(r.body, &event))
+				require.Equal(t, domain.EventVersion, event.Version)
+				require.Equal(t, &pr.PullRequest.PullRequestId, event.Data.PullRequestId)
+				require.Equal(t, string(event.Type), r.header.Get(service.HeaderWebhookEvent))
+				require.Equal(t, event.Id.String(), r.header.Get(service.HeaderWebhookEventId))
+				types[event.Type]++
+			}
+		}
+		// подпись первого webhook-а сходится только для его доставок
+		require.Equal(t, map[domain.EventType]int{
+			domain.EventPullRequestCreated: 1,
+			domain.EventReviewerAssigned:   2,
+			domain.EventPullRequestMerged:  1,
+		}, types)
+
+		repos := newReposFromPool(pool, testDB.Getter)
+		deliveries, err := repos.WebhookDelivery.ListByWebhookId(ctx, merged.WebhookId)
+		require.NoError(t, err)
+		require.Len(t, deliveries, 1)
+		require.Equal(t, domain.WebhookDeliveryDelivered, deliveries[0].Status)
+		require.Equal(t, 1, deliveries[0].Attempts)
+
+		// повторный запуск ничего не отправляет
+		dispatchAll(ctx, t, dispatcher)
+		require.Len(t, receiver.received(), 5)
+	})
+}
+
+func Test_Webhooks_NewEventTypesNeedExplicitSubscription(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		webhooks := service.NewWebhookService(newReposFromPool(pool, testDB.Getter), postgres.NewTransactionManager(pool))
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		repos := newReposFromPool(pool, testDB.Getter)
+
+		_, legacy, err := webhooks.CreateWebhook(ctx, "https://hooks.example.com/legacy", nil)
+		require.NoError(t, err)
+		_, reviews, err := webhooks.CreateWebhook(ctx, "https://hooks.example.com/reviews", []domain.EventType{
+			domain.EventReviewSubmitted,
+		})
+		require.NoError(t, err)
+
+		_, created := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-hook-types", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+		pr, err := prService.CreateAndAssignPullRequest(ctx, uuid.New(), "types", created[0].UserId, false)
+		require.NoError(t, err)
+		_, err = prService.SubmitReview(ctx, pr.PullRequest.PullRequestId, pr.Reviewers[0], domain.ReviewStateApproved)
+		require.NoError(t, err)
+
+		deliveredTypes := func(webhookId uuid.UUID) []domain.EventType {
+			deliveries, err := repos.WebhookDelivery.ListByWebhookId(ctx, webhookId)
+			require.NoError(t, err)
+			out := make([]domain.EventType, len(deliveries))
+			for i, d := range deliveries {
+				out[i] = d.EventType
+			}
+			return out
+		}
+
+		// webhook без подписки получает только типы первой версии webhook-ов
+		require.ElementsMatch(t, []domain.EventType{
+			domain.EventPullRequestCreated,
+			domain.EventReviewerAssigned,
+		}, deliveredTypes(legacy.WebhookId))
+		require.Equal(t, []domain.EventType{domain.EventReviewSubmitted}, deliveredTypes(reviews.WebhookId))
+	})
+}
+
+func Test_WebhookDispatcher_RetriesWithBackoff(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		flaky := &webhookReceiver{statuses: []int{http.StatusInternalServerError, http.StatusBadGateway}}
+		flakyServer := httptest.NewServer(flaky)
+		defer flakyServer.Close()
+		broken := &webhookReceiver{statuses: []int{500, 500, 500, 500}}
+		brokenServer := httptest.NewServer(broken)
+		defer brokenServer.Close()
+
+		repos := newReposFromPool(pool, testDB.Getter)
+		webhooks := service.NewWebhookService(repos, postgres.NewTransactionManager(pool))
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+		dispatcher := newWebhookDispatcher(pool, service.WebhookDispatcherConfig{
+			MaxAttempts: 3,
+			BaseBackoff: 200 * time.Millisecond,
+			MaxBackoff:  400 * time.Millisecond,
+		})
+
+		created := []domain.EventType{domain.EventPullRequestCreated}
+		_, flakyHook, err := webhooks.CreateWebhook(ctx, flakyServer.URL, created)
+		require.NoError(t, err)
+		_, brokenHook, err := webhooks.CreateWebhook(ctx, brokenServer.URL, created)
+		require.NoError(t, err)
+
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-retry", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+		})
+		_, err = prService.CreateAndAssignPullRequest(ctx, uuid.New(), "retry", users[0].UserId, false)
+		require.NoError(t, err)
+
+		dispatchAll(ctx, t, dispatcher)
+		require.Len(t, flaky.received(), 1)
+
+		// повтор ещё не наступил
+		dispatchAll(ctx, t, dispatcher)
+		require.Len(t, flaky.received(), 1)
+
+		deliveries, err := repos.WebhookDelivery.ListByWebhookId(ctx, flakyHook.WebhookId)
+		require.NoError(t, err)
+		require.Equal(t, domain.WebhookDeliveryPending, deliveries[0].Status)
+		require.Equal(t, 1, deliveries[0].Attempts)
+		require.Contains(t, deliveries[0].LastError, "500")
+
+		require.Eventually(t, func() bool {
+			if _, err := dispatcher.DispatchPending(ctx); err != nil {
+				return false
+			}
+			deliveries, err := repos.WebhookDelivery.ListByWebhookId(ctx, brokenHook.WebhookId)
+			return err == nil && deliveries[0].Status == domain.WebhookDeliveryFailed
+		}, 5*time.Second, 20*time.Millisecond)
+
+		deliveries, err = repos.WebhookDelivery.ListByWebhookId(ctx, flakyHook.WebhookId)
+		require.NoError(t, err)
+		require.Equal(t, domain.WebhookDeliveryDelivered, deliveries[0].Status)
+		require.Equal(t, 3, deliveries[0].Attempts)
+		require.NotNil(t, deliveries[0].DeliveredAt)
+		// все попытки несли одно и то же событие
+		received := flaky.received()
+		require.Len(t, received, 3)
+		require.Equal(t, received[0].body, received[2].body)
+
+		// после MaxAttempts доставка больше не повторяется
+		require.Len(t, broken.received(), 3)
+		time.Sleep(400 * time.Millisecond)
+		dispatchAll(ctx, t, dispatcher)
+		require.Len(t, broken.received(), 3)
+	})
+}
+
+func Test_WebhookService_ManageWebhooks(t *testing.T) {
+
+	helpers.WithTestDatabase(t, testDB.Pool, func(ctx context.Context, pool *pgxpool.Pool) {
+		repos := newReposFromPool(pool, testDB.Getter)
+		webhooks := service.NewWebhookService(repos, postgres.NewTransactionManager(pool))
+		prService := newPRServiceFromPool(pool, testDB.Getter)
+
+		_, _, err := webhooks.CreateWebhook(ctx, "ftp://example.com/hook", nil)
+		require.ErrorIs(t, err, service.ErrInvalidWebhook)
+		_, _, err = webhooks.CreateWebhook(ctx, "/relative", nil)
+		require.ErrorIs(t, err, service.ErrInvalidWebhook)
+		_, _, err = webhooks.CreateWebhook(ctx, "https://example.com/hook", []domain.EventType{"team.created"})
+		require.ErrorIs(t, err, service.ErrInvalidWebhook)
+
+		_, hook, err := webhooks.CreateWebhook(ctx, "https://example.com/hook", []domain.EventType{
+			domain.EventReviewerAssigned, domain.EventReviewerAssigned,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []domain.EventType{domain.EventReviewerAssigned}, hook.EventTypes)
+
+		inactive := false
+		updated, err := webhooks.UpdateWebhook(ctx, hook.WebhookId, domain.WebhookUpdate{IsActive: &inactive})
+		require.NoError(t, err)
+		require.False(t, updated.IsActive)
+		require.Equal(t, hook.URL, updated.URL)
+
+		// неактивный webhook не получает событий
+		_, users := setupTeamWithUsers(ctx, t, pool, testDB.Getter, "team-manage", []domain.User{
+			{UserId: uuid.New(), Username: "author", IsActive: true},
+			{UserId: uuid.New(), Username: "u1", IsActive: true},
+		})
+		_, err = prService.CreateAndAssignPullRequest(ctx, uuid.New(), "quiet", users[0].UserId, false)
+		require.NoError(t, err)
+		deliveries, err := repos.WebhookDelivery.ListByWebhookId(ctx, hook.WebhookId)
+		require.NoError(t, err)
+		require.Empty(t, deliveries)
+
+		badURL := "not a url"
+		_, err = webhooks.UpdateWebhook(ctx, hook.WebhookId, domain.WebhookUpdate{URL: &badURL})
+		require.ErrorIs(t, err, service.ErrInvalidWebhook)
+		_, err = webhooks.UpdateWebhook(ctx, uuid.New(), domain.WebhookUpdate{})
+		require.ErrorIs(t, err, service.ErrNotFound)
+
+		list, err := webhooks.ListWebhooks(ctx)
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+
+		deleted, err := webhooks.DeleteWebhook(ctx, hook.WebhookId)
+		require.NoError(t, err)
+		require.Equal(t, hook.WebhookId, deleted.WebhookId)
+		_, err = webhooks.DeleteWebhook(ctx, hook.WebhookId)
+		require.ErrorIs(t, err, service.ErrNotFound)
+	})
+}